@@ -0,0 +1,286 @@
+package graphs
+
+import (
+	"container/heap"
+	"math"
+)
+
+// DefaultDamping is the customary PageRank damping factor
+const DefaultDamping = 0.85
+
+/**
+ * Computes PageRank by power iteration.
+ *
+ * @param damping     probability of following an edge rather
+ *                    than jumping to a random vertex, usually
+ *                    DefaultDamping; NaN and values outside
+ *                    [0, 1] are replaced by DefaultDamping
+ * @param iterations  number of power iteration steps
+ * @return the rank of each vertex, summing to 1
+ *
+ * Each undirected edge is followed in both directions and
+ * weights are ignored. The rank of a vertex with no edges
+ * is spread evenly over all vertices.
+ */
+func (g *Undirected) PageRank(damping float64, iterations int) []float64 {
+	n := g.numVertices
+	rank := make([]float64, n)
+	if n == 0 {
+		return rank
+	}
+	if !(damping >= 0 && damping <= 1) {
+		damping = DefaultDamping
+	}
+
+	for v := range rank {
+		rank[v] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+
+	for i := 0; i < iterations; i++ {
+		dangling := 0.0
+		for v := range next {
+			next[v] = 0
+			if g.degrees[v] == 0 {
+				dangling += rank[v]
+			}
+		}
+		for v := range rank {
+			if g.degrees[v] == 0 {
+				continue
+			}
+			share := rank[v] / float64(g.degrees[v])
+			for _, neighbor := range g.edges[v] {
+				next[neighbor] += share
+			}
+		}
+
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for v := range next {
+			next[v] = base + damping*next[v]
+		}
+		rank, next = next, rank
+	}
+	return rank
+}
+
+/**
+ * Computes the betweenness centrality of every vertex with
+ * Brandes' algorithm.
+ *
+ * @return for each vertex v the sum, over pairs of other
+ *         vertices s and t, of the fraction of shortest
+ *         s-t paths that pass through v, or nil if any edge
+ *         weight is negative
+ *
+ * Unit weight graphs are searched breadth first in O(nm)
+ * time, and weighted graphs with Dijkstra's algorithm in
+ * O(nm + n^2 log n). Only pairs with a path between them
+ * contribute. In an undirected graph each pair is counted
+ * once.
+ */
+func (g *Undirected) BetweennessCentrality() []float64 {
+	centrality := make([]float64, g.numVertices)
+	delta := make([]float64, g.numVertices)
+
+	for source := 0; source < g.numVertices; source++ {
+		order, preds, sigma, _, ok := g.shortestPathDAG(source)
+		if !ok {
+			return nil
+		}
+		for _, v := range order {
+			delta[v] = 0
+		}
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+			if w != source {
+				centrality[w] += delta[w]
+			}
+		}
+	}
+
+	if !g.directed {
+		for v := range centrality {
+			centrality[v] /= 2
+		}
+	}
+	return centrality
+}
+
+/**
+ * Computes the closeness centrality of a vertex.
+ *
+ * @param v  vertex in the graph
+ * @return the reciprocal of the average weighted distance
+ *         from v to the vertices it can reach, scaled by
+ *         the fraction of other vertices it can reach
+ *         (Wasserman and Faust); 0 if v reaches nothing or
+ *         is not in the graph, +Inf if everything v reaches
+ *         is at distance 0 through zero weight edges, and
+ *         NaN if a negative edge weight is reached
+ */
+func (g *Undirected) ClosenessCentrality(v int) float64 {
+	dist, _, ok := g.dijkstra(v, -1)
+	if !ok {
+		return math.NaN()
+	}
+	if dist == nil {
+		return 0
+	}
+	return closeness(dist, v)
+}
+
+/**
+ * Computes the closeness centrality of every vertex from
+ * a single AllPairsShortestPaths pass.
+ *
+ * @return the ClosenessCentrality of each vertex, so NaN
+ *         for every vertex that can reach a negative edge
+ *         weight
+ *
+ * Floyd-Warshall accepts negative weights, so vertices
+ * that reach one are set to NaN here rather than given
+ * the distances it computes.
+ */
+func (g *Undirected) AllClosenessCentrality() []float64 {
+	negative := make([]bool, g.numVertices)
+	for u := 0; u < g.numVertices; u++ {
+		for _, w := range g.edges[u] {
+			if g.weights[u][w] < 0 {
+				negative[u] = true
+			}
+		}
+	}
+
+	centrality := make([]float64, g.numVertices)
+	for v, dist := range g.AllPairsShortestPaths().dist {
+		centrality[v] = closeness(dist, v)
+		for u, d := range dist {
+			if negative[u] && !math.IsInf(d, 1) {
+				centrality[v] = math.NaN()
+				break
+			}
+		}
+	}
+	return centrality
+}
+
+/**
+ * Closeness from one vertex's distances to every vertex.
+ *
+ * @param dist  distances, +Inf for unreachable vertices
+ * @param v     the vertex the distances are from
+ * @return the Wasserman-Faust closeness, 0 if nothing is
+ *         reached and +Inf if everything reached is at
+ *         distance 0
+ */
+func closeness(dist []float64, v int) float64 {
+	reached, total := 0, 0.0
+	for u, d := range dist {
+		if u != v && !math.IsInf(d, 1) {
+			reached++
+			total += d
+		}
+	}
+	if reached == 0 {
+		return 0
+	}
+	if total == 0 {
+		return math.Inf(1)
+	}
+	r := float64(reached)
+	return r / float64(len(dist)-1) * r / total
+}
+
+/**
+ * Finds every shortest path from a source, as in Brandes'
+ * algorithm, breadth first for unit weights and otherwise
+ * with Dijkstra's algorithm.
+ *
+ * @param source  the vertex to search from
+ * @return the reached vertices in order of distance, the
+ *         predecessors of each vertex on its shortest
+ *         paths, the number of shortest paths to each
+ *         vertex, the distance to each vertex, and false if
+ *         a negative edge weight was reached
+ */
+func (g *Undirected) shortestPathDAG(source int) ([]int, [][]int, []float64, []float64, bool) {
+	preds := make([][]int, g.numVertices)
+	sigma := make([]float64, g.numVertices)
+	dist := make([]float64, g.numVertices)
+	for v := range dist {
+		dist[v] = math.Inf(1)
+	}
+	sigma[source] = 1
+	dist[source] = 0
+	order := []int{}
+
+	if g.isUnitWeighted() {
+		queue := []int{source}
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			order = append(order, v)
+			for _, w := range g.edges[v] {
+				if math.IsInf(dist[w], 1) {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+		return order, preds, sigma, dist, true
+	}
+
+	settled := make([]bool, g.numVertices)
+	queue := &vertexQueue{{source, 0}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(vertexItem)
+		v := item.vertex
+		if settled[v] {
+			continue
+		}
+		settled[v] = true
+		order = append(order, v)
+
+		for _, w := range g.edges[v] {
+			if g.weights[v][w] < 0 {
+				return nil, nil, nil, nil, false
+			}
+			alt := dist[v] + g.weights[v][w]
+			switch {
+			case alt < dist[w]-weightEpsilon:
+				dist[w] = alt
+				sigma[w] = sigma[v]
+				preds[w] = append(preds[w][:0], v)
+				heap.Push(queue, vertexItem{w, alt})
+			case !settled[w] && math.Abs(alt-dist[w]) <= weightEpsilon:
+				sigma[w] += sigma[v]
+				preds[w] = append(preds[w], v)
+			}
+		}
+	}
+	return order, preds, sigma, dist, true
+}
+
+/**
+ * Accessor for whether every edge has weight 1.
+ *
+ * @return true if the graph has no other weights
+ */
+func (g *Undirected) isUnitWeighted() bool {
+	for v := 0; v < g.numVertices; v++ {
+		for _, neighbor := range g.edges[v] {
+			if g.weights[v][neighbor] != 1 {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package graphs
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestBetweennessCentralityPath(t *testing.T) {
+	// the middle of a path of three lies on the only 0-2 path
+	g := PathGraph(3)
+	want := []float64{0, 1, 0}
+	if got := g.BetweennessCentrality(); !reflect.DeepEqual(got, want) {
+		t.Errorf("BetweennessCentrality() = %v, want %v", got, want)
+	}
+	g.SetWeight(0, 1, 2)
+	if got := g.BetweennessCentrality(); !reflect.DeepEqual(got, want) {
+		t.Errorf("weighted BetweennessCentrality() = %v, want %v", got, want)
+	}
+}
+
+func TestNegativeWeightsRefused(t *testing.T) {
+	g := PathGraph(3)
+	g.SetWeight(1, 2, -1)
+	if got := g.BetweennessCentrality(); got != nil {
+		t.Errorf("BetweennessCentrality() = %v with a negative weight, want nil", got)
+	}
+	if cost, count := g.CountShortestPaths(0, 2); !math.IsNaN(cost) || count != 0 {
+		t.Errorf("CountShortestPaths(0, 2) = %g, %d with a negative weight, want NaN, 0", cost, count)
+	}
+}
+
+func TestAllClosenessCentralityMatchesClosenessCentrality(t *testing.T) {
+	// a weighted path, a weighted triangle, an isolated
+	// vertex, and a pair joined by a zero weight edge
+	g := NewGraph(9)
+	g.AddEdgeWeight(0, 1, 2)
+	g.AddEdgeWeight(1, 2, 0.5)
+	g.AddEdgeWeight(3, 4, 1)
+	g.AddEdgeWeight(4, 5, 3)
+	g.AddEdgeWeight(3, 5, 1.5)
+	g.AddEdgeWeight(7, 8, 0)
+
+	check := func(g *Undirected) {
+		t.Helper()
+		all := g.AllClosenessCentrality()
+		for v := 0; v < g.Order(); v++ {
+			one := g.ClosenessCentrality(v)
+			if !closeEnough(one, all[v]) {
+				t.Errorf("AllClosenessCentrality()[%d] = %g, ClosenessCentrality(%d) = %g", v, all[v], v, one)
+			}
+		}
+	}
+	check(g)
+	if got := g.ClosenessCentrality(6); got != 0 {
+		t.Errorf("ClosenessCentrality(6) = %g for an isolated vertex, want 0", got)
+	}
+	if got := g.ClosenessCentrality(7); !math.IsInf(got, 1) {
+		t.Errorf("ClosenessCentrality(7) = %g across a zero weight edge, want +Inf", got)
+	}
+
+	g.SetWeight(4, 5, -3)
+	check(g)
+	if all := g.AllClosenessCentrality(); !math.IsNaN(all[3]) || math.IsNaN(all[0]) {
+		t.Errorf("AllClosenessCentrality() = %v, want NaN only for the negative triangle", all)
+	}
+}
+
+// closeEnough compares floats to within weightEpsilon,
+// treating NaN as equal to itself and infinities as exact.
+func closeEnough(a, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b)) || math.Abs(a-b) <= weightEpsilon
+}
+
+func TestPageRankInvalidDamping(t *testing.T) {
+	g := PathGraph(4)
+	want := g.PageRank(DefaultDamping, 30)
+	for _, damping := range []float64{math.NaN(), -0.5, 1.5, math.Inf(1)} {
+		got := g.PageRank(damping, 30)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("PageRank(%g) = %v, want the DefaultDamping ranks %v", damping, got, want)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package graphs
+
+import (
+	"sort"
+)
+
+/**
+ * Enumerates every maximal clique using the Bron-Kerbosch
+ * algorithm with pivoting.
+ *
+ * @return each maximal clique as an ascending slice of
+ *         vertices, in lexicographic order
+ *
+ * The outermost level visits vertices in degeneracy order,
+ * which bounds the work on sparse graphs, but a graph can
+ * have exponentially many maximal cliques so the worst case
+ * is exponential. Isolated vertices are cliques of size one.
+ * Edge directions are ignored.
+ */
+func (g *Undirected) MaximalCliques() [][]int {
+	if g.directed {
+		return g.underlying().MaximalCliques()
+	}
+	order, _ := g.DegeneracyOrdering()
+	position := make([]int, g.numVertices)
+	for i, v := range order {
+		position[v] = i
+	}
+
+	cliques := [][]int{}
+	report := func(clique []int) {
+		c := append([]int(nil), clique...)
+		sort.Ints(c)
+		cliques = append(cliques, c)
+	}
+
+	for _, v := range order {
+		later, earlier := []int{}, []int{}
+		for _, neighbor := range g.edges[v] {
+			if position[neighbor] > position[v] {
+				later = append(later, neighbor)
+			} else {
+				earlier = append(earlier, neighbor)
+			}
+		}
+		g.bronKerbosch([]int{v}, later, earlier, report)
+	}
+
+	sort.Slice(cliques, func(i, j int) bool {
+		a, b := cliques[i], cliques[j]
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return len(a) < len(b)
+	})
+	return cliques
+}
+
+/**
+ * One level of Bron-Kerbosch with pivoting.
+ *
+ * @param clique      the clique being grown
+ * @param candidates  vertices that may extend clique
+ * @param excluded    vertices already tried at this level
+ * @param report      called with each maximal clique
+ */
+func (g *Undirected) bronKerbosch(clique, candidates, excluded []int, report func([]int)) {
+	if len(candidates) == 0 {
+		if len(excluded) == 0 {
+			report(clique)
+		}
+		return
+	}
+
+	// pivot on the vertex adjacent to the most candidates
+	pivot, best := -1, -1
+	for _, sets := range [][]int{candidates, excluded} {
+		for _, u := range sets {
+			count := 0
+			for _, c := range candidates {
+				if g.IsConnected(u, c) {
+					count++
+				}
+			}
+			if count > best {
+				pivot, best = u, count
+			}
+		}
+	}
+
+	for _, v := range append([]int(nil), candidates...) {
+		if g.IsConnected(pivot, v) {
+			continue
+		}
+		g.bronKerbosch(append(clique[:len(clique):len(clique)], v),
+			g.neighborsIn(v, candidates), g.neighborsIn(v, excluded), report)
+		candidates = removeNeighbor(candidates, v)
+		excluded = append(excluded, v)
+	}
+}
+
+/**
+ * Filters a set of vertices down to the neighbors of v.
+ *
+ * @param v    vertex in the graph
+ * @param set  the vertices to filter
+ * @return a new slice of the members of set adjacent to v
+ */
+func (g *Undirected) neighborsIn(v int, set []int) []int {
+	result := []int{}
+	for _, u := range set {
+		if g.IsConnected(v, u) {
+			result = append(result, u)
+		}
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package graphs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaximalCliquesMoonMoser(t *testing.T) {
+	// K(3,3,3), the Moon-Moser graph on 9 vertices, has the
+	// most maximal cliques possible: 3^3, one per choice of a
+	// vertex from each part
+	g := CompleteGraph(9)
+	for part := 0; part < 9; part += 3 {
+		g.RemoveEdge(part, part+1)
+		g.RemoveEdge(part, part+2)
+		g.RemoveEdge(part+1, part+2)
+	}
+
+	cliques := g.MaximalCliques()
+	if len(cliques) != 27 {
+		t.Fatalf("found %d maximal cliques, want 27", len(cliques))
+	}
+	for _, c := range cliques {
+		if len(c) != 3 || c[0]/3 != 0 || c[1]/3 != 1 || c[2]/3 != 2 {
+			t.Errorf("clique %v does not take one vertex from each part", c)
+		}
+	}
+}
+
+func TestMaximalCliques(t *testing.T) {
+	// a triangle with a pendant, and an isolated vertex
+	g := NewGraphFromEdges(5, [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}})
+	want := [][]int{{0, 1, 2}, {2, 3}, {4}}
+	if got := g.MaximalCliques(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MaximalCliques() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,252 @@
+package graphs
+
+import (
+	"sort"
+)
+
+/**
+ * Accessor for whether the graph is bipartite.
+ *
+ * @return true and the side (0 or 1) of each vertex if the
+ *         vertices split into two sets with no edges inside
+ *         either set, otherwise false and nil
+ *
+ * Each component is colored breadth first from its smallest
+ * vertex, which always gets color 0. Edge directions are
+ * ignored.
+ */
+func (g *Undirected) IsBipartite() (bool, []int) {
+	colors, _, conflict := g.twoColor()
+	if conflict[0] != -1 {
+		return false, nil
+	}
+	return true, colors
+}
+
+/**
+ * Finds a cycle of odd length, which shows the graph is
+ * not bipartite.
+ *
+ * @return the vertices of an odd cycle in order around it,
+ *         without the first vertex repeated at the end, or
+ *         nil if the graph is bipartite
+ *
+ * The cycle is closed by the first edge found joining two
+ * vertices of the same color in IsBipartite's coloring.
+ */
+func (g *Undirected) OddCycle() []int {
+	_, parent, conflict := g.twoColor()
+	u, v := conflict[0], conflict[1]
+	if u == -1 {
+		return nil
+	}
+
+	// the breadth first tree paths from u and v meet at
+	// their lowest common ancestor, v's side taken backwards
+	depth := func(x int) int {
+		d := 0
+		for ; parent[x] != -1; x = parent[x] {
+			d++
+		}
+		return d
+	}
+	left, right := []int{u}, []int{v}
+	for du, dv := depth(u), depth(v); du != dv || u != v; {
+		if du >= dv {
+			u = parent[u]
+			du--
+			left = append(left, u)
+		} else {
+			v = parent[v]
+			dv--
+			right = append(right, v)
+		}
+	}
+
+	// left ends at the ancestor, right repeats it
+	cycle := left
+	for i := len(right) - 2; i >= 0; i-- {
+		cycle = append(cycle, right[i])
+	}
+	return cycle
+}
+
+/**
+ * Two-colors each component breadth first from its
+ * smallest vertex, shared by IsBipartite and OddCycle.
+ *
+ * @return the color of each vertex colored so far, the
+ *         parent of each in the breadth first forest (-1
+ *         for roots), and the first edge found with both
+ *         ends the same color, or (-1, -1) if there is none
+ */
+func (g *Undirected) twoColor() ([]int, []int, [2]int) {
+	if g.directed {
+		return g.underlying().twoColor()
+	}
+	colors := make([]int, g.numVertices)
+	parent := make([]int, g.numVertices)
+	for v := range colors {
+		colors[v] = -1
+		parent[v] = -1
+	}
+
+	for root := 0; root < g.numVertices; root++ {
+		if colors[root] != -1 {
+			continue
+		}
+		colors[root] = 0
+		queue := []int{root}
+
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			for _, neighbor := range g.edges[v] {
+				if colors[neighbor] == -1 {
+					colors[neighbor] = 1 - colors[v]
+					parent[neighbor] = v
+					queue = append(queue, neighbor)
+				} else if colors[neighbor] == colors[v] {
+					return colors, parent, [2]int{v, neighbor}
+				}
+			}
+		}
+	}
+	return colors, parent, [2]int{-1, -1}
+}
+
+/**
+ * Colors the vertices greedily so no two adjacent vertices
+ * share a color.
+ *
+ * @param order  the order to color the vertices in, nil
+ *               for the Welsh-Powell order
+ * @return the color of each vertex, numbered from 0, and
+ *         the number of colors used
+ *
+ * Each vertex takes the smallest color not used by an
+ * already colored neighbor. The Welsh-Powell order is by
+ * descending degree, ties by ascending id. Duplicates and
+ * ids outside the graph in order are ignored, and vertices
+ * it leaves out are colored last in ascending order. Edge
+ * directions are ignored, so in a directed graph the
+ * degree counts edges both in and out.
+ */
+func (g *Undirected) GreedyColoring(order []int) ([]int, int) {
+	if g.directed {
+		return g.underlying().GreedyColoring(order)
+	}
+	if order == nil {
+		order = make([]int, g.numVertices)
+		for v := range order {
+			order[v] = v
+		}
+		sort.SliceStable(order, func(i, j int) bool {
+			return g.degrees[order[i]] > g.degrees[order[j]]
+		})
+	}
+
+	colors := make([]int, g.numVertices)
+	for v := range colors {
+		colors[v] = -1
+	}
+	used := make([]bool, g.numVertices+1)
+	count := 0
+
+	color := func(v int) {
+		for _, neighbor := range g.edges[v] {
+			if colors[neighbor] != -1 {
+				used[colors[neighbor]] = true
+			}
+		}
+		c := 0
+		for used[c] {
+			c++
+		}
+		colors[v] = c
+		count = maxInt(count, c+1)
+		for _, neighbor := range g.edges[v] {
+			if colors[neighbor] != -1 {
+				used[colors[neighbor]] = false
+			}
+		}
+	}
+
+	for _, v := range order {
+		if g.hasVertex(v) && colors[v] == -1 {
+			color(v)
+		}
+	}
+	for v := range colors {
+		if colors[v] == -1 {
+			color(v)
+		}
+	}
+	return colors, count
+}
+
+/**
+ * Colors the vertices so no two adjacent vertices share a
+ * color, using Brelaz's DSATUR heuristic.
+ *
+ * @return the color of each vertex, numbered from 0, and
+ *         the number of colors used
+ *
+ * The next vertex colored is always the one whose colored
+ * neighbors already use the most distinct colors, ties by
+ * descending degree and then ascending id, and it takes
+ * the smallest color they do not use. This often needs
+ * fewer colors than GreedyColoring and takes O(n^2 + m)
+ * time. Edge directions are ignored, as by GreedyColoring.
+ */
+func (g *Undirected) ColoringDSATUR() ([]int, int) {
+	if g.directed {
+		return g.underlying().ColoringDSATUR()
+	}
+	colors := make([]int, g.numVertices)
+	for v := range colors {
+		colors[v] = -1
+	}
+	// neighborColors[v] holds the colors of v's colored neighbors
+	neighborColors := make([]map[int]bool, g.numVertices)
+	for v := range neighborColors {
+		neighborColors[v] = map[int]bool{}
+	}
+	count := 0
+
+	for colored := 0; colored < g.numVertices; colored++ {
+		next := -1
+		for v := 0; v < g.numVertices; v++ {
+			if colors[v] != -1 {
+				continue
+			}
+			if next == -1 || len(neighborColors[v]) > len(neighborColors[next]) ||
+				(len(neighborColors[v]) == len(neighborColors[next]) && g.degrees[v] > g.degrees[next]) {
+				next = v
+			}
+		}
+
+		c := 0
+		for neighborColors[next][c] {
+			c++
+		}
+		colors[next] = c
+		count = maxInt(count, c+1)
+		for _, neighbor := range g.edges[next] {
+			neighborColors[neighbor][c] = true
+		}
+	}
+	return colors, count
+}
+
+/**
+ * Accessor for an upper bound on the chromatic number.
+ *
+ * @return the fewer of the numbers of colors used by
+ *         GreedyColoring and ColoringDSATUR
+ */
+func (g *Undirected) ChromaticUpperBound() int {
+	_, greedy := g.GreedyColoring(nil)
+	_, dsatur := g.ColoringDSATUR()
+	return minInt(greedy, dsatur)
+}
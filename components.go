@@ -0,0 +1,143 @@
+package graphs
+
+import (
+	"sort"
+)
+
+/**
+ * Computes the connected components of the graph.
+ *
+ * @return one slice of vertices per component
+ *
+ * Each component is sorted in ascending order and the
+ * components are ordered by their smallest vertex, so
+ * isolated vertices appear as singleton components. A
+ * directed graph is split into its weakly connected
+ * components, ignoring edge directions.
+ */
+func (g *Undirected) ConnectedComponents() [][]int {
+	if g.directed {
+		return g.underlying().ConnectedComponents()
+	}
+	components := [][]int{}
+	visited := make([]bool, g.numVertices)
+
+	for v := 0; v < g.numVertices; v++ {
+		if visited[v] {
+			continue
+		}
+		component := g.BFS(v)
+		for _, u := range component {
+			visited[u] = true
+		}
+		sort.Ints(component)
+		components = append(components, component)
+	}
+	return components
+}
+
+/**
+ * Finds the connected component containing a vertex.
+ *
+ * @param v  vertex in the graph
+ * @return the vertices of v's component in ascending
+ *         order, weakly connected for a directed graph,
+ *         nil if v is not a vertex
+ */
+func (g *Undirected) ComponentOf(v int) []int {
+	if !g.hasVertex(v) {
+		return nil
+	}
+	component := g.underlying().BFS(v)
+	sort.Ints(component)
+	return component
+}
+
+/**
+ * Accessor for the number of connected components.
+ *
+ * @return number of connected components in the graph
+ */
+func (g *Undirected) NumComponents() int {
+	return len(g.ConnectedComponents())
+}
+
+/**
+ * Accessor for whether the whole graph is connected.
+ *
+ * @return true if every vertex can be reached from vertex 0
+ *         ignoring edge directions, so a directed graph need
+ *         only be weakly connected; graphs with zero or one
+ *         vertex are connected
+ *
+ * Not to be confused with IsConnected, which reports whether
+ * two particular vertices share an edge.
+ */
+func (g *Undirected) IsConnectedGraph() bool {
+	if g.numVertices <= 1 {
+		return true
+	}
+	return len(g.underlying().BFS(0)) == g.numVertices
+}
+
+/**
+ * Computes which vertices can reach which others.
+ *
+ * @return an n x n matrix where [i][j] is true if there is
+ *         a path from i to j, following edge directions in
+ *         a directed graph
+ *
+ * Every vertex reaches itself. In an undirected graph the
+ * matrix is symmetric and true exactly within each
+ * connected component, so it is filled in from the
+ * components. A directed graph needs one breadth first
+ * search per vertex, taking O(n(n + m)) time. Either way
+ * the matrix takes O(n^2) memory.
+ */
+func (g *Undirected) TransitiveClosure() [][]bool {
+	reach := make([][]bool, g.numVertices)
+	for i := range reach {
+		reach[i] = make([]bool, g.numVertices)
+	}
+
+	if !g.directed {
+		for _, component := range g.ConnectedComponents() {
+			for _, i := range component {
+				for _, j := range component {
+					reach[i][j] = true
+				}
+			}
+		}
+		return reach
+	}
+
+	for i := range reach {
+		for _, j := range g.BFS(i) {
+			reach[i][j] = true
+		}
+	}
+	return reach
+}
+
+/**
+ * Splits the graph into one subgraph per connected
+ * component.
+ *
+ * @return the components as independent graphs, each
+ *         relabeled 0..k-1, and for each the original id
+ *         of every new vertex
+ *
+ * The components are in the order of ConnectedComponents,
+ * and within each the vertices keep their relative order.
+ * The subgraphs share no storage with g or each other, so
+ * they may be used from separate goroutines.
+ */
+func (g *Undirected) ComponentSubgraphs() ([]*Undirected, [][]int) {
+	components := g.ConnectedComponents()
+	subgraphs := make([]*Undirected, len(components))
+	originals := make([][]int, len(components))
+	for i, component := range components {
+		subgraphs[i], originals[i] = g.InducedSubgraph(component)
+	}
+	return subgraphs, originals
+}
@@ -0,0 +1,45 @@
+package graphs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTransitiveClosureUndirected(t *testing.T) {
+	g := NewGraphFromEdges(6, [][2]int{{0, 2}, {2, 4}, {1, 5}})
+	label := make([]int, g.Order())
+	for i, component := range g.ConnectedComponents() {
+		for _, v := range component {
+			label[v] = i
+		}
+	}
+
+	reach := g.TransitiveClosure()
+	for i := range reach {
+		if !reach[i][i] {
+			t.Errorf("reach[%d][%d] = false, want every vertex to reach itself", i, i)
+		}
+		for j := range reach[i] {
+			if want := label[i] == label[j]; reach[i][j] != want {
+				t.Errorf("reach[%d][%d] = %v, want %v", i, j, reach[i][j], want)
+			}
+		}
+	}
+}
+
+func TestTransitiveClosureDirected(t *testing.T) {
+	d := NewDirectedGraph(4)
+	d.AddEdge(0, 1)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 1)
+
+	want := [][]bool{
+		{true, true, true, false},
+		{false, true, true, false},
+		{false, true, true, false},
+		{false, false, false, true},
+	}
+	if got := d.TransitiveClosure(); !reflect.DeepEqual(got, want) {
+		t.Errorf("TransitiveClosure() = %v, want %v", got, want)
+	}
+}
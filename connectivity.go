@@ -0,0 +1,317 @@
+package graphs
+
+import (
+	"sort"
+)
+
+/**
+ * Finds the articulation points of the graph.
+ *
+ * @return the vertices whose removal would increase the
+ *         number of connected components, ascending; nil
+ *         for a directed graph
+ */
+func (g *Undirected) ArticulationPoints() []int {
+	if g.directed {
+		return nil
+	}
+	isPoint, _ := g.lowLinks()
+	points := []int{}
+	for v, point := range isPoint {
+		if point {
+			points = append(points, v)
+		}
+	}
+	return points
+}
+
+/**
+ * Finds the bridges of the graph.
+ *
+ * @return the edges whose removal would increase the number
+ *         of connected components, as (u, v) with u < v
+ *         sorted by u then v; nil for a directed graph
+ */
+func (g *Undirected) Bridges() [][2]int {
+	if g.directed {
+		return nil
+	}
+	_, bridges := g.lowLinks()
+	sortEdges(bridges)
+	return bridges
+}
+
+/**
+ * Accessor for whether an edge is a bridge.
+ *
+ * @param u  one endpoint
+ * @param v  one endpoint
+ * @return true if uv is an edge and removing it would
+ *         disconnect u from v; false if uv is not an edge
+ *         or the graph is directed
+ *
+ * The graph is not modified; v is searched for from u
+ * while skipping the edge uv, in O(n + m) time.
+ */
+func (g *Undirected) IsBridge(u, v int) bool {
+	if g.directed || !g.IsConnected(u, v) {
+		return false
+	}
+
+	visited := make([]bool, g.numVertices)
+	visited[u] = true
+	stack := []int{u}
+	for len(stack) > 0 {
+		x := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, y := range g.edges[x] {
+			if x == u && y == v {
+				continue
+			}
+			if y == v {
+				return false
+			}
+			if !visited[y] {
+				visited[y] = true
+				stack = append(stack, y)
+			}
+		}
+	}
+	return true
+}
+
+/**
+ * Accessor for whether the graph survives the loss of any
+ * single edge.
+ *
+ * @return true if the undirected graph is connected and
+ *         has no bridges
+ *
+ * Graphs with zero or one vertex have no edges to lose
+ * and are 2-edge-connected. Directed graphs are not.
+ */
+func (g *Undirected) Is2EdgeConnected() bool {
+	if g.directed {
+		return false
+	}
+	return g.IsConnectedGraph() && len(g.Bridges()) == 0
+}
+
+/**
+ * Accessor for whether the graph survives the loss of any
+ * single vertex.
+ *
+ * @return true if the undirected graph is connected and
+ *         has no articulation points
+ *
+ * As with Is2EdgeConnected, graphs with zero or one vertex
+ * count as 2-vertex-connected, and so does a single edge,
+ * since removing either end leaves one vertex. Directed
+ * graphs are not.
+ */
+func (g *Undirected) Is2VertexConnected() bool {
+	if g.directed {
+		return false
+	}
+	return g.IsConnectedGraph() && len(g.ArticulationPoints()) == 0
+}
+
+/**
+ * Splits the edges of the graph into biconnected
+ * components, the maximal sets of edges in which any two
+ * edges lie on a common simple cycle.
+ *
+ * @return the edges of each component as (u, v) with u < v,
+ *         sorted within each component, with the components
+ *         ordered by their first edge; nil for a directed
+ *         graph
+ *
+ * Each bridge is a component of its own, and components
+ * meet only at articulation points. Isolated vertices
+ * belong to no component. The search is Hopcroft and
+ * Tarjan's, keeping the edges of the current component
+ * on a stack, in O(n + m) time.
+ */
+func (g *Undirected) BiconnectedComponents() [][][2]int {
+	if g.directed {
+		return nil
+	}
+	type frame struct {
+		vertex, parent, next int
+	}
+
+	discovery := make([]int, g.numVertices)
+	low := make([]int, g.numVertices)
+	for v := range discovery {
+		discovery[v] = -1
+	}
+	components := [][][2]int{}
+	edges := [][2]int{}
+	time := 0
+
+	for root := 0; root < g.numVertices; root++ {
+		if discovery[root] != -1 {
+			continue
+		}
+		discovery[root], low[root] = time, time
+		time++
+		stack := []frame{{root, -1, 0}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			v := top.vertex
+
+			if top.next < len(g.edges[v]) {
+				w := g.edges[v][top.next]
+				top.next++
+				if w == top.parent {
+					continue
+				}
+				if discovery[w] == -1 {
+					discovery[w], low[w] = time, time
+					time++
+					edges = append(edges, [2]int{minInt(v, w), maxInt(v, w)})
+					stack = append(stack, frame{w, v, 0})
+				} else if discovery[w] < discovery[v] {
+					edges = append(edges, [2]int{minInt(v, w), maxInt(v, w)})
+					low[v] = minInt(low[v], discovery[w])
+				}
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+			p := top.parent
+			if p == -1 {
+				continue
+			}
+			low[p] = minInt(low[p], low[v])
+			if low[v] < discovery[p] {
+				continue
+			}
+
+			// p separates v's subtree, so its edges form a component
+			tree := [2]int{minInt(p, v), maxInt(p, v)}
+			component := [][2]int{}
+			for {
+				e := edges[len(edges)-1]
+				edges = edges[:len(edges)-1]
+				component = append(component, e)
+				if e == tree {
+					break
+				}
+			}
+			sortEdges(component)
+			components = append(components, component)
+		}
+	}
+
+	sort.Slice(components, func(i, j int) bool {
+		a, b := components[i][0], components[j][0]
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		}
+		return a[1] < b[1]
+	})
+	return components
+}
+
+/**
+ * Sorts edges by their first endpoint, then their second.
+ *
+ * @param edges  the edges to sort in place
+ */
+func sortEdges(edges [][2]int) {
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i][0] != edges[j][0] {
+			return edges[i][0] < edges[j][0]
+		}
+		return edges[i][1] < edges[j][1]
+	})
+}
+
+/**
+ * Runs Tarjan's low-link depth first search over every
+ * component, using an explicit stack.
+ *
+ * @return whether each vertex is an articulation point,
+ *         and the bridges found, each as (u, v) with u < v
+ */
+func (g *Undirected) lowLinks() ([]bool, [][2]int) {
+	type frame struct {
+		vertex, parent, next int
+	}
+
+	discovery := make([]int, g.numVertices)
+	low := make([]int, g.numVertices)
+	for v := range discovery {
+		discovery[v] = -1
+	}
+	isPoint := make([]bool, g.numVertices)
+	bridges := [][2]int{}
+	time := 0
+
+	for root := 0; root < g.numVertices; root++ {
+		if discovery[root] != -1 {
+			continue
+		}
+		discovery[root], low[root] = time, time
+		time++
+		rootChildren := 0
+		stack := []frame{{root, -1, 0}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			v := top.vertex
+
+			if top.next < len(g.edges[v]) {
+				w := g.edges[v][top.next]
+				top.next++
+				if w == top.parent {
+					continue
+				}
+				if discovery[w] == -1 {
+					discovery[w], low[w] = time, time
+					time++
+					stack = append(stack, frame{w, v, 0})
+				} else if discovery[w] < low[v] {
+					low[v] = discovery[w]
+				}
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+			p := top.parent
+			if p == -1 {
+				continue
+			}
+			if low[v] < low[p] {
+				low[p] = low[v]
+			}
+			if low[v] > discovery[p] {
+				bridges = append(bridges, [2]int{minInt(p, v), maxInt(p, v)})
+			}
+			if p == root {
+				rootChildren++
+			} else if low[v] >= discovery[p] {
+				isPoint[p] = true
+			}
+		}
+
+		isPoint[root] = rootChildren > 1
+	}
+	return isPoint, bridges
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,45 @@
+package graphs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBiconnectedComponentsBowtie(t *testing.T) {
+	// two triangles sharing vertex 2, and a bridge hanging off 4
+	g := NewGraphFromEdges(7, [][2]int{
+		{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 2}, {4, 5},
+	})
+	want := [][][2]int{
+		{{0, 1}, {0, 2}, {1, 2}},
+		{{2, 3}, {2, 4}, {3, 4}},
+		{{4, 5}},
+	}
+	if got := g.BiconnectedComponents(); !reflect.DeepEqual(got, want) {
+		t.Errorf("BiconnectedComponents() = %v, want %v", got, want)
+	}
+	if g.Is2VertexConnected() {
+		t.Error("Is2VertexConnected() = true for a bowtie")
+	}
+}
+
+func TestBridgesAndArticulationPoints(t *testing.T) {
+	// the path 0-1-2 leading into the cycle 2-3-4-5, with 6 isolated
+	g := NewGraphFromEdges(7, [][2]int{
+		{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 2},
+	})
+	if want := [][2]int{{0, 1}, {1, 2}}; !reflect.DeepEqual(g.Bridges(), want) {
+		t.Errorf("Bridges() = %v, want %v", g.Bridges(), want)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(g.ArticulationPoints(), want) {
+		t.Errorf("ArticulationPoints() = %v, want %v", g.ArticulationPoints(), want)
+	}
+	if !g.IsBridge(2, 1) || g.IsBridge(3, 4) || g.IsBridge(0, 2) {
+		t.Error("IsBridge disagrees with Bridges")
+	}
+
+	cycle := CycleGraph(5)
+	if len(cycle.Bridges()) != 0 || len(cycle.ArticulationPoints()) != 0 {
+		t.Errorf("a cycle has bridges %v and articulation points %v", cycle.Bridges(), cycle.ArticulationPoints())
+	}
+}
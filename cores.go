@@ -0,0 +1,110 @@
+package graphs
+
+/**
+ * Computes the k-core of the graph.
+ *
+ * @param k  the minimum degree
+ * @return the largest subgraph in which every vertex has
+ *         degree at least k, relabeled as by
+ *         InducedSubgraph, and the original id of each
+ *         of its vertices in ascending order
+ */
+func (g *Undirected) KCore(k int) (*Undirected, []int) {
+	vertices := []int{}
+	for v, core := range g.CoreNumbers() {
+		if core >= k {
+			vertices = append(vertices, v)
+		}
+	}
+	return g.InducedSubgraph(vertices)
+}
+
+/**
+ * Computes the core number of every vertex.
+ *
+ * @return for each vertex the largest k such that it
+ *         belongs to the k-core
+ */
+func (g *Undirected) CoreNumbers() []int {
+	_, cores := g.peel()
+	return cores
+}
+
+/**
+ * Computes a degeneracy ordering of the vertices.
+ *
+ * @return the vertices in the order they are removed when
+ *         repeatedly taking a vertex of minimum remaining
+ *         degree, and the degeneracy: the largest remaining
+ *         degree seen at removal
+ *
+ * This uses bucket queues and runs in O(n + m) time.
+ */
+func (g *Undirected) DegeneracyOrdering() ([]int, int) {
+	order, cores := g.peel()
+	degeneracy := 0
+	for _, core := range cores {
+		degeneracy = maxInt(degeneracy, core)
+	}
+	return order, degeneracy
+}
+
+/**
+ * Repeatedly removes a vertex of minimum remaining degree
+ * using the bucket algorithm of Batagelj and Zaversnik,
+ * which runs in O(n + m) time. A directed graph is peeled
+ * by total degree in its underlying undirected graph.
+ *
+ * @return the vertices in the order they were removed, and
+ *         the core number of each vertex
+ */
+func (g *Undirected) peel() ([]int, []int) {
+	if g.directed {
+		return g.underlying().peel()
+	}
+	n := g.numVertices
+	degree := append([]int(nil), g.degrees...)
+	maxDegree := g.MaxDegree()
+
+	// bin[d] is the position in order of the first vertex of degree d
+	bin := make([]int, maxDegree+1)
+	for _, d := range degree {
+		bin[d]++
+	}
+	start := 0
+	for d := range bin {
+		count := bin[d]
+		bin[d] = start
+		start += count
+	}
+
+	order := make([]int, n)
+	position := make([]int, n)
+	for v, d := range degree {
+		position[v] = bin[d]
+		order[position[v]] = v
+		bin[d]++
+	}
+	for d := maxDegree; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	bin[0] = 0
+
+	for i := 0; i < n; i++ {
+		v := order[i]
+		for _, u := range g.edges[v] {
+			if degree[u] <= degree[v] {
+				continue
+			}
+			du, pu := degree[u], position[u]
+			pw := bin[du]
+			if w := order[pw]; u != w {
+				order[pu], order[pw] = w, u
+				position[u], position[w] = pw, pu
+			}
+			bin[du]++
+			degree[u]--
+		}
+	}
+	return order, degree
+}
@@ -0,0 +1,30 @@
+package graphs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCoreNumbers(t *testing.T) {
+	// K4 on 0-3, a triangle 4-5-6 tied to it by 0-4, a
+	// pendant 7 on 6, and 8 isolated
+	g := NewGraphFromEdges(9, [][2]int{
+		{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3},
+		{0, 4}, {4, 5}, {5, 6}, {6, 4}, {6, 7},
+	})
+	want := []int{3, 3, 3, 3, 2, 2, 2, 1, 0}
+	if got := g.CoreNumbers(); !reflect.DeepEqual(got, want) {
+		t.Errorf("CoreNumbers() = %v, want %v", got, want)
+	}
+	if _, degeneracy := g.DegeneracyOrdering(); degeneracy != 3 {
+		t.Errorf("degeneracy %d, want 3", degeneracy)
+	}
+
+	core, originals := g.KCore(2)
+	if want := []int{0, 1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(originals, want) {
+		t.Errorf("KCore(2) vertices = %v, want %v", originals, want)
+	}
+	if core.Size() != 10 {
+		t.Errorf("KCore(2) has %d edges, want 10", core.Size())
+	}
+}
@@ -0,0 +1,156 @@
+package graphs
+
+/**
+ * Accessor for whether the graph contains a cycle.
+ *
+ * @return true if some cycle exists
+ *
+ * Each component is searched depth first, tracking the
+ * parent of every vertex so the edge back to it is not
+ * mistaken for a cycle. A directed graph has a cycle
+ * exactly when it has no topological ordering.
+ */
+func (g *Undirected) HasCycle() bool {
+	if g.directed {
+		_, acyclic := g.kahn()
+		return !acyclic
+	}
+
+	parent := make([]int, g.numVertices)
+	visited := make([]bool, g.numVertices)
+
+	for root := 0; root < g.numVertices; root++ {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		parent[root] = -1
+		stack := []int{root}
+
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			for _, neighbor := range g.edges[v] {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					parent[neighbor] = v
+					stack = append(stack, neighbor)
+				} else if neighbor != parent[v] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+/**
+ * Finds a cycle of the graph.
+ *
+ * @return the vertices of some cycle in order around it,
+ *         without the first vertex repeated at the end, or
+ *         nil if the graph has none
+ *
+ * The cycle closed by the first back edge of a
+ * DepthFirstSearch is returned, following edge directions
+ * in a directed graph. Use Girth for a shortest cycle.
+ */
+func (g *Undirected) FindCycle() []int {
+	var cycle []int
+	parent := make([]int, g.numVertices)
+	g.DepthFirstSearch(DFSHooks{
+		Edge: func(u, v int, kind EdgeKind) {
+			if kind == TreeEdge {
+				parent[v] = u
+			}
+			if kind != BackEdge || cycle != nil {
+				return
+			}
+			// u descends from v, so walk the tree back up
+			for x := u; x != v; x = parent[x] {
+				cycle = append(cycle, x)
+			}
+			cycle = append(cycle, v)
+			for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+				cycle[i], cycle[j] = cycle[j], cycle[i]
+			}
+		},
+	})
+	return cycle
+}
+
+/**
+ * Accessor for whether the graph is a tree.
+ *
+ * @return true if the undirected graph is connected,
+ *         acyclic and has exactly n-1 edges
+ */
+func (g *Undirected) IsTree() bool {
+	if g.directed || g.numVertices == 0 {
+		return false
+	}
+	return g.numEdges == g.numVertices-1 && g.IsConnectedGraph()
+}
+
+/**
+ * Finds a shortest cycle of the undirected graph, ignoring
+ * edge weights.
+ *
+ * @return the number of edges in the shortest cycle and its
+ *         vertices in order around the cycle, without the
+ *         first vertex repeated at the end, or -1 and nil if
+ *         the graph is a forest or is directed
+ *
+ * A breadth first search is run from each vertex, where
+ * every non-tree edge closes a cycle through the tree, so
+ * this takes O(n(n + m)) time. Each search stops once it
+ * is too deep to beat the shortest cycle found so far.
+ * Since there are no self loops or parallel edges, the
+ * girth is never less than 3.
+ */
+func (g *Undirected) Girth() (int, []int) {
+	if g.directed {
+		return -1, nil
+	}
+
+	best := -1
+	var cycle []int
+	dist := make([]int, g.numVertices)
+	parent := make([]int, g.numVertices)
+
+	for root := 0; root < g.numVertices && best != 3; root++ {
+		for i := range dist {
+			dist[i] = -1
+		}
+		dist[root] = 0
+		parent[root] = -1
+		queue := []int{root}
+
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			// any cycle found from here on is no shorter than best
+			if best != -1 && 2*dist[v]+1 >= best {
+				break
+			}
+
+			for _, neighbor := range g.edges[v] {
+				if dist[neighbor] == -1 {
+					dist[neighbor] = dist[v] + 1
+					parent[neighbor] = v
+					queue = append(queue, neighbor)
+				} else if neighbor != parent[v] {
+					if length := dist[v] + dist[neighbor] + 1; best == -1 || length < best {
+						best = length
+						cycle = pathTo(parent, v)
+						for u := neighbor; u != root; u = parent[u] {
+							cycle = append(cycle, u)
+						}
+					}
+				}
+			}
+		}
+	}
+	return best, cycle
+}
@@ -0,0 +1,172 @@
+package graphs
+
+/**
+ * EdgeKind classifies an edge by how a depth first search
+ * reached it.
+ */
+type EdgeKind int
+
+const (
+	// TreeEdge leads to a vertex discovered through it
+	TreeEdge EdgeKind = iota
+	// BackEdge leads to an ancestor still being explored
+	BackEdge
+	// ForwardEdge leads to a descendant already finished,
+	// and only occurs in directed graphs
+	ForwardEdge
+	// CrossEdge leads to a vertex in a finished subtree
+	// that is not a descendant, and only occurs in
+	// directed graphs
+	CrossEdge
+)
+
+func (k EdgeKind) String() string {
+	switch k {
+	case TreeEdge:
+		return "tree"
+	case BackEdge:
+		return "back"
+	case ForwardEdge:
+		return "forward"
+	case CrossEdge:
+		return "cross"
+	}
+	return "unknown"
+}
+
+/**
+ * DFSHooks are the callbacks of DepthFirstSearch. Any of
+ * them may be nil.
+ */
+type DFSHooks struct {
+	// PreVisit is called when a vertex is discovered
+	PreVisit func(v int)
+	// PostVisit is called when every neighbor of a vertex
+	// has been explored
+	PostVisit func(v int)
+	// Edge is called once for each edge, from the end it
+	// was explored from, with its classification
+	Edge func(u, v int, kind EdgeKind)
+}
+
+/**
+ * DFSResult records a depth first search. Discovery and
+ * finish times come from one counter starting at 0, so a
+ * vertex's subtree is exactly the vertices discovered
+ * between its own discovery and finish.
+ */
+type DFSResult struct {
+	Discovery []int // when each vertex was discovered
+	Finish    []int // when each vertex was finished
+	Parent    []int // parent in the search forest, -1 for roots
+}
+
+/**
+ * Depth first search of the whole graph, reporting each
+ * vertex and edge to the hooks.
+ *
+ * @param hooks  the callbacks to run during the search
+ * @return the discovery and finish times and search forest
+ *
+ * Unvisited vertices are taken as roots in ascending
+ * order, and neighbors are explored in ascending order.
+ * In an undirected graph every edge is reported once,
+ * as a tree edge or as a back edge from the descendant
+ * to the ancestor, and the edge back to a vertex's parent
+ * is the tree edge itself. The search uses an explicit
+ * stack so large graphs do not exhaust the goroutine stack.
+ */
+func (g *Undirected) DepthFirstSearch(hooks DFSHooks) *DFSResult {
+	result := g.newDFSResult()
+	time := 0
+	for root := 0; root < g.numVertices; root++ {
+		if result.Discovery[root] == -1 {
+			g.depthFirstFrom(root, result, &time, hooks)
+		}
+	}
+	return result
+}
+
+/**
+ * Constructor for a DFSResult with no vertex reached.
+ */
+func (g *Undirected) newDFSResult() *DFSResult {
+	result := &DFSResult{
+		Discovery: make([]int, g.numVertices),
+		Finish:    make([]int, g.numVertices),
+		Parent:    make([]int, g.numVertices),
+	}
+	for v := range result.Discovery {
+		result.Discovery[v] = -1
+		result.Finish[v] = -1
+		result.Parent[v] = -1
+	}
+	return result
+}
+
+/**
+ * Iterative depth first search of one tree of the forest,
+ * shared by DepthFirstSearch, DFS and DFSPostorder.
+ *
+ * @param root    an undiscovered vertex to start from
+ * @param result  the times and parents so far, updated
+ * @param time    the counter for discovery and finish times
+ * @param hooks   the callbacks to run
+ */
+func (g *Undirected) depthFirstFrom(root int, result *DFSResult, time *int, hooks DFSHooks) {
+	type frame struct {
+		vertex    int
+		neighbors []int
+		next      int
+	}
+
+	discover := func(v int) frame {
+		result.Discovery[v] = *time
+		*time++
+		if hooks.PreVisit != nil {
+			hooks.PreVisit(v)
+		}
+		return frame{v, g.sortedEdges(v), 0}
+	}
+
+	stack := []frame{discover(root)}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		u := top.vertex
+		if top.next == len(top.neighbors) {
+			result.Finish[u] = *time
+			*time++
+			if hooks.PostVisit != nil {
+				hooks.PostVisit(u)
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		v := top.neighbors[top.next]
+		top.next++
+
+		var kind EdgeKind
+		switch {
+		case result.Discovery[v] == -1:
+			kind = TreeEdge
+		case !g.directed && (v == result.Parent[u] || result.Discovery[v] > result.Discovery[u]):
+			// the same edge as a tree or back edge already reported
+			continue
+		case result.Finish[v] == -1:
+			kind = BackEdge
+		case result.Discovery[v] > result.Discovery[u]:
+			kind = ForwardEdge
+		default:
+			kind = CrossEdge
+		}
+
+		if hooks.Edge != nil {
+			hooks.Edge(u, v, kind)
+		}
+		if kind == TreeEdge {
+			result.Parent[v] = u
+			stack = append(stack, discover(v))
+		}
+	}
+}
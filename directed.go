@@ -0,0 +1,287 @@
+package graphs
+
+import (
+	"container/heap"
+	"errors"
+)
+
+var (
+	// ErrNotDirected is returned by operations that need edge directions
+	ErrNotDirected = errors.New("graphs: graph is not directed")
+	// ErrDirected is returned by operations that cannot keep edge directions
+	ErrDirected = errors.New("graphs: graph is directed")
+	// ErrCycle is returned when an acyclic graph was required
+	ErrCycle = errors.New("graphs: graph contains a cycle")
+)
+
+/**
+ * Directed is a directed graph. It shares its storage and
+ * algorithms with Undirected, where an edge from x to y is
+ * kept only in x's adjacency list, so every Undirected
+ * method is available on it. Traversals, paths, distances,
+ * centrality and cycles follow edge directions.
+ *
+ * Methods with no directed meaning of their own work on the
+ * underlying undirected graph instead: connected components
+ * are weakly connected, and triangles, clustering, cores,
+ * cliques, colorings, bipartiteness, matchings and
+ * spanning trees ignore directions. Bridges, articulation points,
+ * biconnectivity, MinCut, IsTree and ComponentTracker are
+ * for undirected graphs only and report nil, false or no
+ * cut on a directed one.
+ *
+ * Methods of Undirected that build a new graph, such as
+ * InducedSubgraph, return it as a directed *Undirected;
+ * wrap it as &Directed{g} for the methods below.
+ */
+type Directed struct {
+	*Undirected
+}
+
+/**
+ * Constructor sets up the adjacency lists for a directed
+ *       graph with a set number of vertices, and no edges
+ *
+ * @param num  number of vertices in the graph
+ */
+func NewDirectedGraph(numVertices int) *Directed {
+	g := new(Undirected)
+	g.directed = true
+	g.numVertices = numVertices
+	g.Clear()
+	return &Directed{g}
+}
+
+/**
+ * Accessor for the vertices an edge from v leads to.
+ *
+ * @param v  vertex in the graph
+ * @return a copy of v's out-neighbors, nil if v is not
+ *         a vertex
+ */
+func (d *Directed) OutNeighbors(v int) []int {
+	return d.Neighbors(v)
+}
+
+/**
+ * Accessor for the vertices with an edge leading to v.
+ *
+ * @param v  vertex in the graph
+ * @return v's in-neighbors in ascending order, nil if v is
+ *         not a vertex
+ *
+ * Only out-neighbors are listed, so this scans a column
+ * of the adjacency matrix in O(n) time.
+ */
+func (d *Directed) InNeighbors(v int) []int {
+	if !d.hasVertex(v) {
+		return nil
+	}
+	neighbors := make([]int, 0, d.inDegrees[v])
+	for u := 0; u < d.numVertices; u++ {
+		if d.adjacencies[u][v] {
+			neighbors = append(neighbors, u)
+		}
+	}
+	return neighbors
+}
+
+/**
+ * Copies the graph.
+ *
+ * @return a deep copy that shares no storage with d
+ */
+func (d *Directed) Clone() *Directed {
+	return &Directed{d.Undirected.Clone()}
+}
+
+/**
+ * Builds the graph with every edge reversed.
+ *
+ * @return a new graph with an edge from y to x of the same
+ *         weight for each edge from x to y
+ */
+func (d *Directed) Transpose() *Directed {
+	t := NewDirectedGraph(d.numVertices)
+	for _, e := range d.WeightedEdgeList() {
+		t.AddEdgeWeight(e.V, e.U, e.W)
+	}
+	return t
+}
+
+/**
+ * Orders the vertices of a directed acyclic graph so every
+ * edge u -> v has u before v, using Kahn's algorithm.
+ *
+ * @return the ordering, or ErrCycle if the graph has a cycle
+ *
+ * When several vertices are ready the smallest is taken
+ * first, so the ordering is deterministic.
+ */
+func (d *Directed) TopologicalSort() ([]int, error) {
+	order, ok := d.kahn()
+	if !ok {
+		return nil, ErrCycle
+	}
+	return order, nil
+}
+
+/**
+ * Kahn's algorithm behind TopologicalSort, also used by
+ * HasCycle on directed graphs.
+ *
+ * @return the vertices in topological order, smallest
+ *         first among those ready, and false if a cycle
+ *         kept some vertices out of the order
+ */
+func (g *Undirected) kahn() ([]int, bool) {
+	inDegrees := make([]int, g.numVertices)
+	copy(inDegrees, g.inDegrees)
+
+	ready := &intHeap{}
+	for v := 0; v < g.numVertices; v++ {
+		if inDegrees[v] == 0 {
+			heap.Push(ready, v)
+		}
+	}
+
+	order := make([]int, 0, g.numVertices)
+	for ready.Len() > 0 {
+		v := heap.Pop(ready).(int)
+		order = append(order, v)
+		for _, next := range g.edges[v] {
+			inDegrees[next]--
+			if inDegrees[next] == 0 {
+				heap.Push(ready, next)
+			}
+		}
+	}
+	return order, len(order) == g.numVertices
+}
+
+/**
+ * Min-heap of vertices implementing heap.Interface.
+ */
+type intHeap []int
+
+func (h intHeap) Len() int            { return len(h) }
+func (h intHeap) Less(i, j int) bool  { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x interface{}) { *h = append(*h, x.(int)) }
+
+func (h *intHeap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+/**
+ * Finds the strongly connected components, the maximal
+ * sets of vertices that can all reach each other, using
+ * Tarjan's algorithm with an explicit stack.
+ *
+ * @return the component label of each vertex, and the
+ *         number of components
+ *
+ * Labels run 0..count-1 in topological order of the
+ * condensation, so any edge between components runs from
+ * a smaller label to a larger one. This takes O(n + m)
+ * time.
+ */
+func (d *Directed) StronglyConnectedComponents() ([]int, int) {
+	n := d.numVertices
+	index := make([]int, n)
+	low := make([]int, n)
+	label := make([]int, n)
+	onStack := make([]bool, n)
+	for v := range index {
+		index[v] = -1
+	}
+
+	type frame struct {
+		vertex, next int
+	}
+	stack := []int{}
+	count, time := 0, 0
+
+	for root := 0; root < n; root++ {
+		if index[root] != -1 {
+			continue
+		}
+		index[root], low[root] = time, time
+		time++
+		stack = append(stack, root)
+		onStack[root] = true
+		calls := []frame{{root, 0}}
+
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.vertex
+			if top.next < len(d.edges[v]) {
+				w := d.edges[v][top.next]
+				top.next++
+				if index[w] == -1 {
+					index[w], low[w] = time, time
+					time++
+					stack = append(stack, w)
+					onStack[w] = true
+					calls = append(calls, frame{w, 0})
+				} else if onStack[w] {
+					low[v] = minInt(low[v], index[w])
+				}
+				continue
+			}
+
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				parent := calls[len(calls)-1].vertex
+				low[parent] = minInt(low[parent], low[v])
+			}
+			if low[v] == index[v] {
+				for {
+					w := stack[len(stack)-1]
+					stack = stack[:len(stack)-1]
+					onStack[w] = false
+					label[w] = count
+					if w == v {
+						break
+					}
+				}
+				count++
+			}
+		}
+	}
+
+	// Tarjan finishes sink components first
+	for v := range label {
+		label[v] = count - 1 - label[v]
+	}
+	return label, count
+}
+
+/**
+ * Builds the condensation, the graph with each strongly
+ * connected component merged into one vertex.
+ *
+ * @return a directed acyclic graph with a vertex per
+ *         component and a unit weight edge between two
+ *         components when any edge joins them, and the
+ *         component label of each original vertex
+ *
+ * Vertices of the condensation are the labels from
+ * StronglyConnectedComponents, so 0..count-1 is already a
+ * topological ordering.
+ */
+func (d *Directed) Condensation() (*Directed, []int) {
+	label, count := d.StronglyConnectedComponents()
+	dag := NewDirectedGraph(count)
+	for u := 0; u < d.numVertices; u++ {
+		for _, v := range d.edges[u] {
+			if label[u] != label[v] {
+				dag.AddEdge(label[u], label[v])
+			}
+		}
+	}
+	return dag, label
+}
@@ -0,0 +1,189 @@
+package graphs
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// directedTriangle has the edges 0->1, 2->1 and 0->2, which
+// form a triangle but not a directed cycle.
+func directedTriangle() *Directed {
+	d := NewDirectedGraph(3)
+	d.AddEdge(0, 1)
+	d.AddEdge(2, 1)
+	d.AddEdge(0, 2)
+	return d
+}
+
+func TestDirectedWeakComponents(t *testing.T) {
+	d := NewDirectedGraph(4)
+	d.AddEdge(1, 0)
+	d.AddEdge(3, 2)
+
+	want := [][]int{{0, 1}, {2, 3}}
+	if got := d.ConnectedComponents(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ConnectedComponents() = %v, want %v", got, want)
+	}
+	if got := d.NumComponents(); got != 2 {
+		t.Errorf("NumComponents() = %d, want 2", got)
+	}
+	if got := d.ComponentOf(0); !reflect.DeepEqual(got, []int{0, 1}) {
+		t.Errorf("ComponentOf(0) = %v, want [0 1]", got)
+	}
+	if d.IsConnectedGraph() {
+		t.Error("IsConnectedGraph() = true with two weak components")
+	}
+	d.AddEdge(2, 1)
+	if !d.IsConnectedGraph() {
+		t.Error("IsConnectedGraph() = false for a weakly connected graph")
+	}
+}
+
+func TestDirectedUndirectedOnly(t *testing.T) {
+	d := directedTriangle()
+	if got := d.Bridges(); got != nil {
+		t.Errorf("Bridges() = %v, want nil", got)
+	}
+	if got := d.ArticulationPoints(); got != nil {
+		t.Errorf("ArticulationPoints() = %v, want nil", got)
+	}
+	if d.IsBridge(0, 1) {
+		t.Error("IsBridge(0, 1) = true on a directed graph")
+	}
+	if size, cut := d.MinCut(nil, 1); size != 0 || cut != nil {
+		t.Errorf("MinCut() = %d, %v, want 0, nil", size, cut)
+	}
+}
+
+func TestDirectedTriangles(t *testing.T) {
+	d := directedTriangle()
+	if got := d.CountTriangles(); got != 1 {
+		t.Errorf("CountTriangles() = %d, want 1", got)
+	}
+	if got := d.GlobalClusteringCoefficient(); got != 1 {
+		t.Errorf("GlobalClusteringCoefficient() = %g, want 1", got)
+	}
+	if got := d.ClusteringCoefficient(1); got != 1 {
+		t.Errorf("ClusteringCoefficient(1) = %g, want 1", got)
+	}
+	if bipartite, _ := d.IsBipartite(); bipartite {
+		t.Error("IsBipartite() = true for a triangle")
+	}
+}
+
+func TestDirectedColoring(t *testing.T) {
+	d := directedTriangle()
+	for name, color := range map[string]func() ([]int, int){
+		"GreedyColoring": func() ([]int, int) { return d.GreedyColoring(nil) },
+		"ColoringDSATUR": d.ColoringDSATUR,
+	} {
+		colors, count := color()
+		if count != 3 {
+			t.Errorf("%s used %d colors, want 3", name, count)
+		}
+		for _, e := range d.EdgeList() {
+			if colors[e[0]] == colors[e[1]] {
+				t.Errorf("%s gave both ends of %v color %d", name, e, colors[e[0]])
+			}
+		}
+	}
+	if got := d.ChromaticUpperBound(); got != 3 {
+		t.Errorf("ChromaticUpperBound() = %d, want 3", got)
+	}
+}
+
+func TestDirectedEulerianPath(t *testing.T) {
+	d := NewDirectedGraph(2)
+	d.AddEdge(0, 1)
+	if path, ok := d.EulerianPath(); !ok || !reflect.DeepEqual(path, []int{0, 1}) {
+		t.Errorf("EulerianPath() = %v, %v, want [0 1], true", path, ok)
+	}
+	if d.HasEulerianCircuit() {
+		t.Error("HasEulerianCircuit() = true for a single edge")
+	}
+
+	// a directed cycle through every vertex, then a chord
+	// that leaves 1 with an extra out edge and 3 an extra in
+	d = NewDirectedGraph(4)
+	for v := 0; v < 4; v++ {
+		d.AddEdge(v, (v+1)%4)
+	}
+	if path, ok := d.EulerianPath(); !ok || !reflect.DeepEqual(path, []int{0, 1, 2, 3, 0}) {
+		t.Errorf("EulerianPath() = %v, %v, want [0 1 2 3 0], true", path, ok)
+	}
+	d.AddEdge(1, 3)
+	if d.HasEulerianCircuit() {
+		t.Error("HasEulerianCircuit() = true with unbalanced degrees")
+	}
+	edges, ok := d.EulerianEdges()
+	if !ok || len(edges) != d.Size() || edges[0][0] != 1 || edges[len(edges)-1][1] != 3 {
+		t.Fatalf("EulerianEdges() = %v, %v, want a walk of every edge from 1 to 3", edges, ok)
+	}
+	for _, e := range edges {
+		if !d.IsConnected(e[0], e[1]) {
+			t.Errorf("EulerianEdges() walks %v, which is not an edge", e)
+		}
+	}
+
+	// both edges leave 0, so no walk can use them both
+	d = NewDirectedGraph(3)
+	d.AddEdge(0, 1)
+	d.AddEdge(0, 2)
+	if path, ok := d.EulerianPath(); ok {
+		t.Errorf("EulerianPath() = %v, true for two edges out of 0", path)
+	}
+}
+
+func TestTopologicalSort(t *testing.T) {
+	d := NewDirectedGraph(5)
+	d.AddEdge(3, 1)
+	d.AddEdge(1, 0)
+	d.AddEdge(4, 0)
+	d.AddEdge(2, 4)
+
+	// 2 and 3 start ready, and the smaller is always taken
+	want := []int{2, 3, 1, 4, 0}
+	if got, err := d.TopologicalSort(); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("TopologicalSort() = %v, %v, want %v, nil", got, err, want)
+	}
+	if d.HasCycle() {
+		t.Error("HasCycle() = true for a DAG")
+	}
+
+	d.AddEdge(0, 2)
+	if got, err := d.TopologicalSort(); !errors.Is(err, ErrCycle) || got != nil {
+		t.Errorf("TopologicalSort() = %v, %v, want nil, ErrCycle", got, err)
+	}
+	if !d.HasCycle() {
+		t.Error("HasCycle() = false with the cycle 0 -> 2 -> 4 -> 0")
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	// {4, 5} -> {0, 2} -> {1, 3, 6}, a chain of cycles with
+	// a single topological order
+	d := NewDirectedGraph(7)
+	for _, e := range [][2]int{
+		{4, 5}, {5, 4}, {4, 0},
+		{0, 2}, {2, 0}, {2, 1},
+		{1, 3}, {3, 6}, {6, 1},
+	} {
+		d.AddEdge(e[0], e[1])
+	}
+
+	labels, count := d.StronglyConnectedComponents()
+	if want := []int{1, 2, 1, 2, 0, 0, 2}; count != 3 || !reflect.DeepEqual(labels, want) {
+		t.Errorf("StronglyConnectedComponents() = %v, %d, want %v, 3", labels, count, want)
+	}
+	for _, e := range d.EdgeList() {
+		if labels[e[0]] > labels[e[1]] {
+			t.Errorf("edge %v runs from label %d back to %d", e, labels[e[0]], labels[e[1]])
+		}
+	}
+
+	condensation, _ := d.Condensation()
+	if want := [][2]int{{0, 1}, {1, 2}}; !reflect.DeepEqual(condensation.EdgeList(), want) {
+		t.Errorf("Condensation() edges = %v, want %v", condensation.EdgeList(), want)
+	}
+}
@@ -0,0 +1,67 @@
+package graphs
+
+import (
+	"math"
+)
+
+/**
+ * Computes the eccentricity of a vertex.
+ *
+ * @param v  vertex in the graph
+ * @return the greatest shortest path cost from v to any
+ *         other vertex, +Inf if some vertex cannot be
+ *         reached or v is not in the graph, and NaN
+ *         if a negative edge weight is reached
+ */
+func (g *Undirected) Eccentricity(v int) float64 {
+	dist, _, ok := g.dijkstra(v, -1)
+	if !ok {
+		return math.NaN()
+	}
+	if dist == nil {
+		return math.Inf(1)
+	}
+	max := 0.0
+	for _, d := range dist {
+		max = math.Max(max, d)
+	}
+	return max
+}
+
+/**
+ * Computes the diameter of the graph.
+ *
+ * @return the greatest shortest path cost between any two
+ *         vertices, and false (with +Inf) if the graph is
+ *         not connected
+ */
+func (g *Undirected) Diameter() (float64, bool) {
+	max := 0.0
+	for _, row := range g.AllPairsShortestPaths().dist {
+		for _, d := range row {
+			max = math.Max(max, d)
+		}
+	}
+	return max, !math.IsInf(max, 1)
+}
+
+/**
+ * Computes the radius of the graph.
+ *
+ * @return the smallest eccentricity of any vertex, and
+ *         false (with +Inf) if the graph is not connected
+ */
+func (g *Undirected) Radius() (float64, bool) {
+	if g.numVertices == 0 {
+		return 0, true
+	}
+	min := math.Inf(1)
+	for _, row := range g.AllPairsShortestPaths().dist {
+		eccentricity := 0.0
+		for _, d := range row {
+			eccentricity = math.Max(eccentricity, d)
+		}
+		min = math.Min(min, eccentricity)
+	}
+	return min, !math.IsInf(min, 1)
+}
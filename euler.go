@@ -0,0 +1,168 @@
+package graphs
+
+/**
+ * Accessor for whether the graph has an Eulerian circuit.
+ *
+ * @return true if a closed walk uses every edge exactly once:
+ *         the vertices with edges are connected and every
+ *         degree is even, or in a directed graph they are
+ *         weakly connected and every in-degree equals the
+ *         out-degree
+ */
+func (g *Undirected) HasEulerianCircuit() bool {
+	start, ok := g.eulerianStart()
+	return ok && start == -1 && g.edgesConnected()
+}
+
+/**
+ * Accessor for whether the graph has an Eulerian path.
+ *
+ * @return true if a walk uses every edge exactly once: the
+ *         vertices with edges are connected and zero or two
+ *         degrees are odd, or in a directed graph they are
+ *         weakly connected and either every vertex has equal
+ *         in- and out-degree or exactly one has an extra out
+ *         edge and one an extra in edge
+ */
+func (g *Undirected) HasEulerianPath() bool {
+	_, ok := g.eulerianStart()
+	return ok && g.edgesConnected()
+}
+
+/**
+ * Constructs an Eulerian path using Hierholzer's algorithm.
+ *
+ * @return the vertices of a walk using every edge exactly
+ *         once, and false if there is no such walk
+ *
+ * The walk starts at the smaller odd degree vertex if there
+ * are two, otherwise at the smallest vertex with an edge, so
+ * it is a circuit whenever one exists. A directed walk
+ * follows edge directions and starts at the vertex with an
+ * extra out edge if there is one. A graph with no edges
+ * gives an empty walk.
+ */
+func (g *Undirected) EulerianPath() ([]int, bool) {
+	if !g.HasEulerianPath() {
+		return nil, false
+	}
+
+	start, _ := g.eulerianStart()
+	for v := 0; start == -1 && v < g.numVertices; v++ {
+		if g.degrees[v] > 0 {
+			start = v
+		}
+	}
+	if start == -1 {
+		return []int{}, true
+	}
+
+	remaining := g.Clone()
+	path := make([]int, 0, g.numEdges+1)
+	stack := []int{start}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		if remaining.degrees[v] == 0 {
+			path = append(path, v)
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		next := remaining.edges[v][0]
+		for _, u := range remaining.edges[v] {
+			if u < next {
+				next = u
+			}
+		}
+		remaining.RemoveEdge(v, next)
+		stack = append(stack, next)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, true
+}
+
+/**
+ * Constructs an Eulerian path as a sequence of edges.
+ *
+ * @return the edges of the walk from EulerianPath in the
+ *         order they are walked, each as (from, to), and
+ *         false if there is no such walk
+ *
+ * Consecutive edges share a vertex, the to of one being
+ * the from of the next. A graph with no edges gives an
+ * empty sequence.
+ */
+func (g *Undirected) EulerianEdges() ([][2]int, bool) {
+	path, ok := g.EulerianPath()
+	if !ok {
+		return nil, false
+	}
+	edges := make([][2]int, 0, g.numEdges)
+	for i := 1; i < len(path); i++ {
+		edges = append(edges, [2]int{path[i-1], path[i]})
+	}
+	return edges, true
+}
+
+/**
+ * Accessor for whether every edge lies in one component.
+ *
+ * @return true if at most one component has an edge,
+ *         weakly connected for a directed graph
+ */
+func (g *Undirected) edgesConnected() bool {
+	withEdges := 0
+	for _, component := range g.ConnectedComponents() {
+		if len(component) > 1 {
+			withEdges++
+		}
+	}
+	return withEdges <= 1
+}
+
+/**
+ * Finds the vertex an Eulerian path is forced to start at
+ * by the degrees alone, ignoring connectivity.
+ *
+ * @return the smaller of two odd degree vertices, or in a
+ *         directed graph the one vertex with an extra out
+ *         edge; -1 if the path may start anywhere, and
+ *         false if the degrees rule out an Eulerian path
+ */
+func (g *Undirected) eulerianStart() (int, bool) {
+	if !g.directed {
+		odd := []int{}
+		for v, degree := range g.degrees {
+			if degree%2 == 1 {
+				odd = append(odd, v)
+			}
+		}
+		switch len(odd) {
+		case 0:
+			return -1, true
+		case 2:
+			return odd[0], true
+		}
+		return -1, false
+	}
+
+	// the extra out and in edges must balance, so one start
+	// implies exactly one end
+	start := -1
+	for v := 0; v < g.numVertices; v++ {
+		switch g.degrees[v] - g.inDegrees[v] {
+		case 0:
+		case 1:
+			if start != -1 {
+				return -1, false
+			}
+			start = v
+		case -1:
+		default:
+			return -1, false
+		}
+	}
+	return start, true
+}
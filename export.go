@@ -0,0 +1,92 @@
+package graphs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/**
+ * Describes the graph in the Graphviz DOT language.
+ *
+ * @param name  name of the graph, may be empty
+ * @return a DOT "graph" listing isolated vertices as nodes
+ *         and every edge once as "u -- v" with u < v, or
+ *         a "digraph" of "u -> v" edges if g is directed
+ *
+ * Edges whose weight is not 1 are labeled with the weight.
+ */
+func (g *Undirected) ToDOT(name string) string {
+	kind, connector := "graph", "--"
+	if g.directed {
+		kind, connector = "digraph", "->"
+	}
+
+	var b strings.Builder
+	if name == "" {
+		fmt.Fprintf(&b, "%s {\n", kind)
+	} else {
+		fmt.Fprintf(&b, "%s %s {\n", kind, dotQuote(name))
+	}
+
+	for v := 0; v < g.numVertices; v++ {
+		if g.degrees[v] == 0 && g.InDegree(v) == 0 {
+			fmt.Fprintf(&b, "\t%d;\n", v)
+		}
+	}
+	for _, e := range g.WeightedEdgeList() {
+		if e.W == 1 {
+			fmt.Fprintf(&b, "\t%d %s %d;\n", e.U, connector, e.V)
+		} else {
+			fmt.Fprintf(&b, "\t%d %s %d [label=\"%s\"];\n", e.U, connector, e.V,
+				strconv.FormatFloat(e.W, 'g', -1, 64))
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+/**
+ * Quotes a DOT ID, escaping only the double quotes and
+ * backslashes inside it so any other text, including
+ * non-ASCII letters, is kept as is.
+ *
+ * @param id  the text to quote
+ * @return id in double quotes
+ */
+func dotQuote(id string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(id) + `"`
+}
+
+/**
+ * Describes the graph for debugging.
+ *
+ * @return the vertex and edge counts followed by one line
+ *         per vertex listing its neighbors in ascending
+ *         order, e.g. "3: [0 1 5(2.5)]" where edges whose
+ *         weight is not 1 show the weight in parentheses
+ */
+func (g *Undirected) String() string {
+	kind := "undirected"
+	if g.directed {
+		kind = "directed"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s graph: %d vertices, %d edges\n", kind, g.numVertices, g.numEdges)
+	for v := 0; v < g.numVertices; v++ {
+		fmt.Fprintf(&b, "%d: [", v)
+		for i, neighbor := range g.sortedEdges(v) {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			fmt.Fprint(&b, neighbor)
+			if w := g.weights[v][neighbor]; w != 1 {
+				fmt.Fprintf(&b, "(%s)", strconv.FormatFloat(w, 'g', -1, 64))
+			}
+		}
+		b.WriteString("]\n")
+	}
+	return b.String()
+}
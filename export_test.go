@@ -0,0 +1,24 @@
+package graphs
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToDOTName(t *testing.T) {
+	g := NewGraph(2)
+	g.AddEdge(0, 1)
+
+	for name, want := range map[string]string{
+		`say "hi"`: `graph "say \"hi\"" {`,
+		`a\b`:      `graph "a\\b" {`,
+		"café":     `graph "café" {`,
+	} {
+		if got := strings.SplitN(g.ToDOT(name), "\n", 2)[0]; got != want {
+			t.Errorf("ToDOT(%q) starts %s, want %s", name, got, want)
+		}
+	}
+	if got := g.ToDOT(""); !strings.HasPrefix(got, "graph {\n\t0 -- 1;\n}") {
+		t.Errorf("ToDOT(\"\") = %q", got)
+	}
+}
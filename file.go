@@ -0,0 +1,406 @@
+package graphs
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// MaxInputVertices caps the vertex count read from files and JSON, as a graph takes O(n^2) memory
+var MaxInputVertices = 10000
+
+/**
+ * Constructor shared by the file constructors reading
+ * edge lists.
+ *
+ * @param filepath       name of the input file
+ * @param tokensPerEdge  as for readEdges
+ * @return the graph, or nil and the first open, parse,
+ *         or range error
+ */
+func newGraphFromPath(filepath string, tokensPerEdge int) (*Undirected, error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return newGraphFromReader(file, filepath, tokensPerEdge)
+}
+
+/**
+ * Constructor shared by the constructors reading edge
+ * lists.
+ *
+ * @param r              the input
+ * @param name           name of the input for error
+ *                       messages, "" if it has none
+ * @param tokensPerEdge  as for readEdges
+ * @return the graph, or nil and the first parse or
+ *         range error
+ */
+func newGraphFromReader(r io.Reader, name string, tokensPerEdge int) (*Undirected, error) {
+	g := new(Undirected)
+	g.Clear()
+	if err := g.readEdges(r, name, tokensPerEdge); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+/**
+ * Constructor for NewGraphFromAdjListFile.
+ *
+ * @param filepath  name of the input file
+ * @return the graph, or nil and the first open, parse,
+ *         or range error
+ */
+func newAdjListGraphFromPath(filepath string) (*Undirected, error) {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return newAdjListGraphFromReader(file, filepath)
+}
+
+/**
+ * Constructor shared by the constructors reading
+ * adjacency lists.
+ *
+ * @param r     the input
+ * @param name  name of the input for error messages, ""
+ *              if it has none
+ * @return the graph, or nil and the first parse or
+ *         range error
+ */
+func newAdjListGraphFromReader(r io.Reader, name string) (*Undirected, error) {
+	g := new(Undirected)
+	g.Clear()
+	if err := g.readAdjList(r, name); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+/**
+ * Inputs the number of vertices and each edge.
+ *
+ * @param r              the input
+ * @param name           name of the input for error
+ *                       messages, "" if it has none
+ * @param tokensPerEdge  2 for unweighted edges, 3 for
+ *                       weighted ones, or 0 to take the
+ *                       count from the first edge line
+ * @return the first read, parse, or range error
+ *
+ *       The format is
+ *       first line: the number of vertices
+ *       subsequent lines: pairs of vertices
+ *                         representing the edges,
+ *                         each followed by the weight
+ *                         of the edge if weighted.
+ *       The edge list ends at the end of the input
+ *       or at a line starting with a negative vertex.
+ *
+ * Blank lines are skipped. Any other line with the wrong
+ * number of entries is an error.
+ */
+func (g *Undirected) readEdges(r io.Reader, name string, tokensPerEdge int) error {
+	f := bufio.NewScanner(r)
+	line := 0
+	header := true
+
+	for f.Scan() {
+		line++
+		fields := strings.Fields(f.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if header {
+			numVertices, err := readVertexCount(fields, name, line)
+			if err != nil {
+				return err
+			}
+			g.numVertices = numVertices
+			g.Clear()
+			header = false
+			continue
+		}
+
+		vertex1, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return fmt.Errorf("graphs: %s: reading edge: %w", position(name, line), err)
+		}
+		if vertex1 < 0 {
+			return nil
+		}
+		if tokensPerEdge == 0 {
+			if len(fields) != 2 && len(fields) != 3 {
+				return fmt.Errorf("graphs: %s: expected 2 or 3 entries per edge, found %d",
+					position(name, line), len(fields))
+			}
+			tokensPerEdge = len(fields)
+		}
+		if len(fields) != tokensPerEdge {
+			return fmt.Errorf("graphs: %s: expected %d entries per edge, found %d",
+				position(name, line), tokensPerEdge, len(fields))
+		}
+
+		vertex2, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return fmt.Errorf("graphs: %s: reading edge: %w", position(name, line), err)
+		}
+
+		weight := 1.0
+		if tokensPerEdge == 3 {
+			weight, err = strconv.ParseFloat(fields[2], 64)
+			if err != nil {
+				return fmt.Errorf("graphs: %s: reading edge weight: %w", position(name, line), err)
+			}
+		}
+
+		if vertex2 < 0 {
+			continue
+		}
+		if vertex1 >= g.numVertices || vertex2 >= g.numVertices {
+			return fmt.Errorf("graphs: %s: edge (%d, %d) exceeds header vertex count %d",
+				position(name, line), vertex1, vertex2, g.numVertices)
+		}
+		g.AddEdgeWeight(vertex1, vertex2, weight)
+	}
+
+	if err := f.Err(); err != nil {
+		return err
+	}
+	if header {
+		return fmt.Errorf("graphs: %s: missing vertex count", position(name, 0))
+	}
+	return nil
+}
+
+/**
+ * Parses the header line holding the vertex count.
+ *
+ * @param fields  the entries of the line
+ * @param name    name of the input for error messages
+ * @param line    line number of the header
+ * @return the vertex count, or an error if the line is not
+ *         a single count between 0 and MaxInputVertices
+ */
+func readVertexCount(fields []string, name string, line int) (int, error) {
+	if len(fields) != 1 {
+		return 0, fmt.Errorf("graphs: %s: expected vertex count, found %d entries",
+			position(name, line), len(fields))
+	}
+	numVertices, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, fmt.Errorf("graphs: %s: reading vertex count: %w", position(name, line), err)
+	}
+	if numVertices < 0 {
+		return 0, fmt.Errorf("graphs: %s: negative vertex count %d", position(name, line), numVertices)
+	}
+	if numVertices > MaxInputVertices {
+		return 0, fmt.Errorf("graphs: %s: vertex count %d exceeds MaxInputVertices %d",
+			position(name, line), numVertices, MaxInputVertices)
+	}
+	return numVertices, nil
+}
+
+/**
+ * Describes where in the input a line is, for errors.
+ *
+ * @param name  name of the input, "" if it has none
+ * @param line  line number counting from 1, or 0 for the
+ *              input as a whole
+ * @return name:line, or "line N" without a name
+ */
+func position(name string, line int) string {
+	switch {
+	case line == 0 && name == "":
+		return "input"
+	case line == 0:
+		return name
+	case name == "":
+		return fmt.Sprintf("line %d", line)
+	}
+	return fmt.Sprintf("%s:%d", name, line)
+}
+
+/**
+ * Inputs the number of vertices and each adjacency list,
+ * in the format described at NewGraphFromAdjListFile.
+ *
+ * @param r     the input
+ * @param name  name of the input for error messages, ""
+ *              if it has none
+ * @return the first read, parse, or range error
+ *
+ * Blank lines are skipped.
+ */
+func (g *Undirected) readAdjList(r io.Reader, name string) error {
+	f := bufio.NewScanner(r)
+	line := 0
+	header := true
+
+	for f.Scan() {
+		line++
+		fields := strings.Fields(f.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		if header {
+			numVertices, err := readVertexCount(fields, name, line)
+			if err != nil {
+				return err
+			}
+			g.numVertices = numVertices
+			g.Clear()
+			header = false
+			continue
+		}
+
+		vertices := make([]int, len(fields))
+		for i, field := range fields {
+			v, err := strconv.Atoi(field)
+			if err != nil {
+				return fmt.Errorf("graphs: %s: reading adjacency list: %w", position(name, line), err)
+			}
+			if !g.hasVertex(v) {
+				return fmt.Errorf("graphs: %s: vertex %d exceeds header vertex count %d",
+					position(name, line), v, g.numVertices)
+			}
+			vertices[i] = v
+		}
+		for _, neighbor := range vertices[1:] {
+			g.AddEdge(vertices[0], neighbor)
+		}
+	}
+
+	if err := f.Err(); err != nil {
+		return err
+	}
+	if header {
+		return fmt.Errorf("graphs: %s: missing vertex count", position(name, 0))
+	}
+	return nil
+}
+
+/**
+ * Outputs the graph to a file in the adjacency list
+ * format read by NewGraphFromAdjListFile.
+ *
+ * @param filepath  name of the output file
+ * @return ErrDirected for a directed graph, which the
+ *         file format cannot mark as such, or any error
+ *         creating or writing the file
+ *
+ *       The file format is
+ *       first line: the number of vertices
+ *       subsequent lines: each vertex followed by
+ *                         its neighbors in ascending
+ *                         order
+ */
+func (g *Undirected) WriteAdjListFile(filepath string) error {
+	if g.directed {
+		return fmt.Errorf("%w: %s cannot record edge directions", ErrDirected, filepath)
+	}
+	file, err := os.Create(filepath)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(file)
+	fmt.Fprintln(w, g.numVertices)
+	for v := 0; v < g.numVertices; v++ {
+		fmt.Fprint(w, v)
+		for _, neighbor := range g.sortedEdges(v) {
+			fmt.Fprint(w, " ", neighbor)
+		}
+		fmt.Fprintln(w)
+	}
+
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+/**
+ * Outputs the graph to a file in the format read by
+ * NewGraphFromFile.
+ *
+ * @param filepath  name of the output file
+ * @return ErrDirected for a directed graph, which the
+ *         file format cannot mark as such, or any error
+ *         creating or writing the file
+ *
+ *       The file format is
+ *       first entry: the number of vertices
+ *       subsequent lines: pairs of vertices
+ *                         representing the edges
+ *       last line: -1 -1
+ */
+func (g *Undirected) WriteToFile(filepath string) error {
+	return g.writeEdgesToFile(filepath, false)
+}
+
+/**
+ * Outputs the graph to a file in the format read by
+ * NewWeightedGraphFromFile.
+ *
+ * @param filepath  name of the output file
+ * @return ErrDirected for a directed graph, which the
+ *         file format cannot mark as such, or any error
+ *         creating or writing the file
+ *
+ *       The file format is
+ *       first entry: the number of vertices
+ *       subsequent lines: pairs of vertices
+ *                         followed by the weight
+ *                         of the edge
+ *       last line: -1 -1
+ */
+func (g *Undirected) WriteWeightedToFile(filepath string) error {
+	return g.writeEdgesToFile(filepath, true)
+}
+
+/**
+ * Shared writer behind WriteToFile and WriteWeightedToFile.
+ *
+ * @param filepath  name of the output file
+ * @param weighted  whether to follow each edge with its weight
+ * @return ErrDirected for a directed graph, or any error
+ *         creating or writing the file
+ */
+func (g *Undirected) writeEdgesToFile(filepath string, weighted bool) error {
+	if g.directed {
+		return fmt.Errorf("%w: %s cannot record edge directions", ErrDirected, filepath)
+	}
+	file, err := os.Create(filepath)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(file)
+	fmt.Fprintln(w, g.numVertices)
+	for _, e := range g.WeightedEdgeList() {
+		if weighted {
+			fmt.Fprintln(w, e.U, e.V, strconv.FormatFloat(e.W, 'g', -1, 64))
+		} else {
+			fmt.Fprintln(w, e.U, e.V)
+		}
+	}
+	fmt.Fprintln(w, -1, -1)
+
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,253 @@
+package graphs
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteRoundTrip(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdgeWeight(0, 3, 2.5)
+	g.AddEdgeWeight(1, 2, 1)
+	path := filepath.Join(t.TempDir(), "graph.txt")
+
+	if err := g.WriteWeightedToFile(path); err != nil {
+		t.Fatal(err)
+	}
+	read, err := NewWeightedGraphFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !read.Equals(g) {
+		t.Errorf("read back %v, want %v", read, g)
+	}
+}
+
+func TestWriteRejectsDirected(t *testing.T) {
+	d := NewDirectedGraph(2)
+	d.AddEdge(1, 0)
+	dir := t.TempDir()
+
+	for name, write := range map[string]func(string) error{
+		"WriteToFile":         d.WriteToFile,
+		"WriteWeightedToFile": d.WriteWeightedToFile,
+		"WriteAdjListFile":    d.WriteAdjListFile,
+	} {
+		path := filepath.Join(dir, name)
+		if err := write(path); !errors.Is(err, ErrDirected) {
+			t.Errorf("%s gave %v, want ErrDirected", name, err)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("%s created %s for a directed graph", name, path)
+		}
+	}
+}
+
+func TestReadEdges(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		weighted bool
+		size     int    // edges read, when there is no error
+		err      string // text the error must contain, "" for none
+	}{
+		{"sentinel", "3\n0 1\n1 2\n-1 -1\n", false, 2, ""},
+		{"no sentinel", "3\n0 1\n1 2\n", false, 2, ""},
+		{"blank lines", "3\n0 1\n\n  \n1 2\n-1 -1\n", false, 2, ""},
+		{"one entry", "3\n0 1\n2\n", false, 0, "line 3: expected 2 entries per edge, found 1"},
+		{"four entries", "3\n0 1 1 1\n", false, 0, "line 2: expected 2 entries per edge, found 4"},
+		{"four entries weighted", "3\n0 1 1 1\n", true, 0, "line 2: expected 3 entries per edge, found 4"},
+		{"two entry header", "3 4\n0 1\n", false, 0, "line 1: expected vertex count, found 2 entries"},
+		{"negative header", "\n-2\n", false, 0, "line 2: negative vertex count -2"},
+		{"empty", "", false, 0, "input: missing vertex count"},
+		{"blank", "\n\n", false, 0, "input: missing vertex count"},
+		{"bad weight", "2\n0 1 heavy\n", true, 0, "line 2: reading edge weight"},
+		{"bad vertex", "2\n0 x\n", false, 0, "line 2: reading edge"},
+	}
+
+	for _, test := range tests {
+		read := NewGraphFromReader
+		if test.weighted {
+			read = NewWeightedGraphFromReader
+		}
+		g, err := read(strings.NewReader(test.input))
+
+		if test.err == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", test.name, err)
+			} else if g.Size() != test.size {
+				t.Errorf("%s: read %d edges, want %d", test.name, g.Size(), test.size)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: error %v, want one containing %q", test.name, err, test.err)
+		}
+		if g != nil {
+			t.Errorf("%s: got graph %v alongside the error, want nil", test.name, g)
+		}
+	}
+}
+
+// writeTemp writes contents to a new file and returns its path.
+func writeTemp(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "graph.txt")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewGraphFromFileErrors(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing.txt")
+	tests := []struct {
+		name   string
+		read   func(string) (*Undirected, error)
+		beyond string // an edge outside a two vertex graph
+	}{
+		{"NewGraphFromFile", NewGraphFromFile, "2\n0 2\n"},
+		{"NewWeightedGraphFromFile", NewWeightedGraphFromFile, "2\n0 2 1.5\n"},
+	}
+	for _, test := range tests {
+		name, read := test.name, test.read
+		g, err := read(missing)
+		var pathErr *fs.PathError
+		if !errors.As(err, &pathErr) || g != nil {
+			t.Errorf("%s(missing) = %v, %v, want nil and a *fs.PathError", name, g, err)
+		}
+
+		path := writeTemp(t, "three\n")
+		if g, err := read(path); err == nil || g != nil {
+			t.Errorf("%s with a bad vertex count = %v, %v, want nil and an error", name, g, err)
+		}
+
+		path = writeTemp(t, test.beyond)
+		g, err = read(path)
+		if err == nil || g != nil {
+			t.Errorf("%s with an edge beyond the header = %v, %v, want nil and an error", name, g, err)
+		} else if want := path + ":2: edge (0, 2) exceeds header vertex count 2"; !strings.Contains(err.Error(), want) {
+			t.Errorf("%s error %q, want one containing %q", name, err, want)
+		}
+	}
+}
+
+func TestNewGraphFromReader(t *testing.T) {
+	g, err := NewGraphFromReader(strings.NewReader("3\n0 1\n2 1\n-1 -1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [][2]int{{0, 1}, {1, 2}}; !reflect.DeepEqual(g.EdgeList(), want) {
+		t.Errorf("EdgeList() = %v, want %v", g.EdgeList(), want)
+	}
+
+	w, err := NewWeightedGraphFromReader(strings.NewReader("3\n0 1 2.5\n2 1 -1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Size() != 2 || w.Weight(1, 0) != 2.5 || w.Weight(1, 2) != -1 {
+		t.Errorf("read weighted edges %v", w.WeightedEdgeList())
+	}
+
+	_, err = NewGraphFromReader(strings.NewReader("3\n0 1\n0 5\n"))
+	if err == nil {
+		t.Fatal("no error for an edge beyond the header")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "graphs: line 3: ") || strings.Contains(msg, ":3") {
+		t.Errorf("error %q, want the position given as line 3", msg)
+	}
+}
+
+func TestNewGraphAutoDetect(t *testing.T) {
+	g, err := NewGraphAutoDetect(writeTemp(t, "3\n0 1\n1 2\n-1 -1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Size() != 2 || g.Weight(0, 1) != 1 {
+		t.Errorf("unweighted file read as %v", g.WeightedEdgeList())
+	}
+
+	g, err = NewGraphAutoDetect(writeTemp(t, "3\n0 1 0.5\n1 2 4\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Size() != 2 || g.Weight(0, 1) != 0.5 || g.Weight(1, 2) != 4 {
+		t.Errorf("weighted file read as %v", g.WeightedEdgeList())
+	}
+
+	g, err = NewGraphAutoDetect(writeTemp(t, "4\n-1 -1\n"))
+	if err != nil || g.Order() != 4 || g.Size() != 0 {
+		t.Errorf("header only file read as %v, %v, want 4 vertices and no edges", g, err)
+	}
+
+	path := writeTemp(t, "3\n0 1 0.5\n1 2\n")
+	g, err = NewGraphAutoDetect(path)
+	if want := path + ":3: expected 3 entries per edge, found 2"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("mixed file gave error %v, want one containing %q", err, want)
+	}
+	if g != nil {
+		t.Errorf("mixed file gave graph %v alongside the error", g)
+	}
+}
+
+func TestAdjListRoundTrip(t *testing.T) {
+	// vertex 3 is isolated
+	g := NewGraphFromEdges(5, [][2]int{{0, 1}, {0, 2}, {2, 4}, {1, 4}})
+	path := filepath.Join(t.TempDir(), "graph.adj")
+	if err := g.WriteAdjListFile(path); err != nil {
+		t.Fatal(err)
+	}
+	read, err := NewGraphFromAdjListFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !read.Equals(g) || read.Degree(3) != 0 {
+		t.Errorf("read back %v, want %v", read, g)
+	}
+
+	path = writeTemp(t, "3\n0 1\n1 0 3\n")
+	read, err = NewGraphFromAdjListFile(path)
+	if want := path + ":3: vertex 3 exceeds header vertex count 3"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("out of range neighbor gave error %v, want one containing %q", err, want)
+	}
+	if read != nil {
+		t.Errorf("out of range neighbor gave graph %v alongside the error", read)
+	}
+
+	_, err = NewGraphFromAdjListReader(strings.NewReader("2\n0 x\n"))
+	if want := "graphs: line 2: reading adjacency list"; err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("bad neighbor gave error %v, want one containing %q", err, want)
+	}
+}
+
+func TestMaxInputVertices(t *testing.T) {
+	huge := "1000000000\n0 1\n"
+	if g, err := NewGraphFromReader(strings.NewReader(huge)); err == nil || g != nil ||
+		!strings.Contains(err.Error(), "line 1: vertex count 1000000000 exceeds MaxInputVertices") {
+		t.Errorf("huge header gave %v, %v", g, err)
+	}
+	if g, err := NewGraphFromAdjListReader(strings.NewReader(huge)); err == nil || g != nil {
+		t.Errorf("huge adjacency list header gave %v, %v", g, err)
+	}
+
+	var g Undirected
+	if err := json.Unmarshal([]byte(`{"vertices":1000000000,"edges":[]}`), &g); err == nil ||
+		!strings.Contains(err.Error(), "exceeds MaxInputVertices") {
+		t.Errorf("huge JSON vertex count gave %v", err)
+	}
+
+	defer func(limit int) { MaxInputVertices = limit }(MaxInputVertices)
+	MaxInputVertices = 3
+	if _, err := NewGraphFromReader(strings.NewReader("4\n")); err == nil {
+		t.Error("vertex count above a lowered MaxInputVertices was accepted")
+	}
+	if _, err := NewGraphFromReader(strings.NewReader("3\n")); err != nil {
+		t.Errorf("vertex count equal to MaxInputVertices gave %v", err)
+	}
+}
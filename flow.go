@@ -0,0 +1,146 @@
+package graphs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrNegativeCapacity is returned when a flow network has an edge of negative weight
+var ErrNegativeCapacity = errors.New("graphs: negative edge capacity")
+
+/**
+ * FlowResult is a maximum flow and the minimum cut that
+ * proves it, as computed by MaxFlow.
+ */
+type FlowResult struct {
+	Value      float64            // total flow from source to sink
+	Flow       map[[2]int]float64 // flow along each edge u -> v with flow
+	SourceSide []int              // vertices on the source side of the cut, ascending
+	CutEdges   [][2]int           // edges from SourceSide to the rest, sorted
+}
+
+/**
+ * Computes a maximum flow from source to sink using
+ * Dinic's algorithm, treating edge weights as capacities.
+ *
+ * @param source  the vertex flow leaves from
+ * @param sink    the vertex flow arrives at
+ * @return the flow and a minimum cut, or an error if
+ *         source and sink are the same vertex,
+ *         ErrVertexOutOfRange if either is not a vertex, and
+ *         ErrNegativeCapacity for a negative weight
+ *
+ * The source side of the cut is every vertex still
+ * reachable from source through edges with spare
+ * capacity, so the cut edges are all full and their
+ * capacities sum to Value. Flows within 1e-9 of zero are
+ * left out of Flow. This takes O(n^2 m) time.
+ */
+func (d *Directed) MaxFlow(source, sink int) (*FlowResult, error) {
+	if !d.hasVertex(source) || !d.hasVertex(sink) {
+		return nil, fmt.Errorf("%w: source %d, sink %d", ErrVertexOutOfRange, source, sink)
+	}
+	if source == sink {
+		return nil, fmt.Errorf("graphs: source and sink are both vertex %d", source)
+	}
+
+	// arcs are stored in pairs, arc i^1 being the reverse of arc i
+	type arc struct {
+		to       int
+		residual float64
+	}
+	arcs := []arc{}
+	out := make([][]int, d.numVertices)
+	for u := 0; u < d.numVertices; u++ {
+		for _, v := range d.edges[u] {
+			capacity := d.weights[u][v]
+			if capacity < 0 {
+				return nil, fmt.Errorf("%w: edge (%d, %d) has weight %g",
+					ErrNegativeCapacity, u, v, capacity)
+			}
+			out[u] = append(out[u], len(arcs))
+			arcs = append(arcs, arc{v, capacity})
+			out[v] = append(out[v], len(arcs))
+			arcs = append(arcs, arc{u, 0})
+		}
+	}
+
+	level := make([]int, d.numVertices)
+	next := make([]int, d.numVertices)
+
+	// levels labels vertices by residual distance from source
+	levels := func() bool {
+		for v := range level {
+			level[v] = -1
+		}
+		level[source] = 0
+		queue := []int{source}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, a := range out[u] {
+				if v := arcs[a].to; level[v] == -1 && arcs[a].residual > weightEpsilon {
+					level[v] = level[u] + 1
+					queue = append(queue, v)
+				}
+			}
+		}
+		return level[sink] != -1
+	}
+
+	// push sends up to limit along level-increasing arcs,
+	// returning the amount that reached sink
+	var push func(u int, limit float64) float64
+	push = func(u int, limit float64) float64 {
+		if u == sink {
+			return limit
+		}
+		for ; next[u] < len(out[u]); next[u]++ {
+			a := out[u][next[u]]
+			v := arcs[a].to
+			if level[v] != level[u]+1 || arcs[a].residual <= weightEpsilon {
+				continue
+			}
+			if pushed := push(v, math.Min(limit, arcs[a].residual)); pushed > 0 {
+				arcs[a].residual -= pushed
+				arcs[a^1].residual += pushed
+				return pushed
+			}
+		}
+		return 0
+	}
+
+	result := &FlowResult{Flow: map[[2]int]float64{}}
+	for levels() {
+		for v := range next {
+			next[v] = 0
+		}
+		for pushed := push(source, math.Inf(1)); pushed > 0; pushed = push(source, math.Inf(1)) {
+			result.Value += pushed
+		}
+	}
+
+	// the reverse arc's residual is the flow along the edge
+	for u := range out {
+		for _, a := range out[u] {
+			if a%2 == 0 && arcs[a^1].residual > weightEpsilon {
+				result.Flow[[2]int{u, arcs[a].to}] = arcs[a^1].residual
+			}
+		}
+	}
+
+	// the last level search stopped at the cut
+	for v, l := range level {
+		if l != -1 {
+			result.SourceSide = append(result.SourceSide, v)
+		}
+	}
+	result.CutEdges = [][2]int{}
+	for _, e := range d.EdgeList() {
+		if level[e[0]] != -1 && level[e[1]] == -1 {
+			result.CutEdges = append(result.CutEdges, e)
+		}
+	}
+	return result, nil
+}
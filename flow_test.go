@@ -0,0 +1,65 @@
+package graphs
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMaxFlowCLRS(t *testing.T) {
+	// the network of CLRS figure 26.1, s = 0 and t = 5
+	d := NewDirectedGraph(6)
+	for _, e := range []WeightedEdge{
+		{0, 1, 16}, {0, 2, 13}, {2, 1, 4}, {1, 3, 12}, {3, 2, 9},
+		{2, 4, 14}, {4, 3, 7}, {3, 5, 20}, {4, 5, 4},
+	} {
+		d.AddEdgeWeight(e.U, e.V, e.W)
+	}
+
+	result, err := d.MaxFlow(0, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(result.Value-23) > weightEpsilon {
+		t.Errorf("Value = %g, want 23", result.Value)
+	}
+	if want := []int{0, 1, 2, 4}; !reflect.DeepEqual(result.SourceSide, want) {
+		t.Errorf("SourceSide = %v, want %v", result.SourceSide, want)
+	}
+	if want := [][2]int{{1, 3}, {4, 3}, {4, 5}}; !reflect.DeepEqual(result.CutEdges, want) {
+		t.Errorf("CutEdges = %v, want %v", result.CutEdges, want)
+	}
+
+	// flow respects capacities and is conserved away from s and t
+	net := make([]float64, d.Order())
+	for e, f := range result.Flow {
+		if f > d.Weight(e[0], e[1])+weightEpsilon {
+			t.Errorf("flow %g along %v exceeds its capacity %g", f, e, d.Weight(e[0], e[1]))
+		}
+		net[e[0]] -= f
+		net[e[1]] += f
+	}
+	for v := 1; v < 5; v++ {
+		if math.Abs(net[v]) > weightEpsilon {
+			t.Errorf("vertex %d has net inflow %g", v, net[v])
+		}
+	}
+	if math.Abs(net[5]-23) > weightEpsilon {
+		t.Errorf("sink receives %g, want 23", net[5])
+	}
+}
+
+func TestMaxFlowErrors(t *testing.T) {
+	d := NewDirectedGraph(3)
+	d.AddEdgeWeight(0, 1, -2)
+	if _, err := d.MaxFlow(0, 1); !errors.Is(err, ErrNegativeCapacity) {
+		t.Errorf("negative capacity gave %v, want ErrNegativeCapacity", err)
+	}
+	if _, err := d.MaxFlow(0, 3); !errors.Is(err, ErrVertexOutOfRange) {
+		t.Errorf("sink 3 gave %v, want ErrVertexOutOfRange", err)
+	}
+	if _, err := d.MaxFlow(1, 1); err == nil {
+		t.Error("source equal to sink gave no error")
+	}
+}
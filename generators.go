@@ -0,0 +1,89 @@
+package graphs
+
+/**
+ * Constructor for the complete graph K_n.
+ *
+ * @param n  number of vertices
+ * @return a graph with a unit weight edge between
+ *         every pair of distinct vertices
+ */
+func CompleteGraph(n int) *Undirected {
+	if n < 0 {
+		n = 0
+	}
+	g := NewGraph(n)
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			g.AddEdge(u, v)
+		}
+	}
+	return g
+}
+
+/**
+ * Constructor for the cycle graph C_n.
+ *
+ * @param n  number of vertices
+ * @return a graph joining each vertex i to i+1, and
+ *         n-1 back to 0
+ *
+ * With two vertices the cycle is a single edge, and with
+ * fewer there are no edges.
+ */
+func CycleGraph(n int) *Undirected {
+	g := PathGraph(n)
+	if n > 2 {
+		g.AddEdge(n-1, 0)
+	}
+	return g
+}
+
+/**
+ * Constructor for the path graph P_n.
+ *
+ * @param n  number of vertices
+ * @return a graph joining each vertex i to i+1
+ */
+func PathGraph(n int) *Undirected {
+	if n < 0 {
+		n = 0
+	}
+	g := NewGraph(n)
+	for v := 1; v < n; v++ {
+		g.AddEdge(v-1, v)
+	}
+	return g
+}
+
+/**
+ * Constructor for a rows x cols 4-connected grid.
+ *
+ * @param rows  number of rows
+ * @param cols  number of columns
+ * @return the grid graph, and a function mapping a
+ *         (row, column) cell to its vertex id
+ *
+ * Cell (r, c) is vertex r*cols + c and is joined to
+ * the cells directly above, below, left and right.
+ */
+func GridGraph(rows, cols int) (*Undirected, func(r, c int) int) {
+	if rows < 0 || cols < 0 {
+		rows, cols = 0, 0
+	}
+	index := func(r, c int) int {
+		return r*cols + c
+	}
+
+	g := NewGraph(rows * cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if r+1 < rows {
+				g.AddEdge(index(r, c), index(r+1, c))
+			}
+			if c+1 < cols {
+				g.AddEdge(index(r, c), index(r, c+1))
+			}
+		}
+	}
+	return g, index
+}
@@ -1,14 +1,52 @@
 package graphs
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
-	"os"
-	"strconv"
+	"io"
+	"math"
 )
 
+var (
+	// ErrVertexOutOfRange is returned when a vertex is not in the graph
+	ErrVertexOutOfRange = errors.New("graphs: vertex out of range")
+	// ErrSelfLoop is returned when an edge would join a vertex to itself
+	ErrSelfLoop = errors.New("graphs: self loop")
+	// ErrDuplicateEdge is matched by a *DuplicateEdgeError using errors.Is
+	ErrDuplicateEdge = errors.New("graphs: duplicate edge")
+)
+
+/**
+ * DuplicateEdgeError reports an attempt to add an edge
+ * that is already in the graph.
+ */
+type DuplicateEdgeError struct {
+	U, V      int
+	Existing  float64 // weight of the edge in the graph
+	Attempted float64 // weight that was passed in
+}
+
+func (e *DuplicateEdgeError) Error() string {
+	if e.WeightDiffers() {
+		return fmt.Sprintf("graphs: duplicate edge (%d, %d) with weight %g, existing weight %g",
+			e.U, e.V, e.Attempted, e.Existing)
+	}
+	return fmt.Sprintf("graphs: duplicate edge (%d, %d)", e.U, e.V)
+}
+
+// Is lets errors.Is match a DuplicateEdgeError against ErrDuplicateEdge.
+func (e *DuplicateEdgeError) Is(target error) bool {
+	return target == ErrDuplicateEdge
+}
+
+// WeightDiffers reports whether the rejected weight differs from the existing one.
+func (e *DuplicateEdgeError) WeightDiffers() bool {
+	return math.Abs(e.Existing-e.Attempted) > weightEpsilon
+}
+
 /**
- * graph is an implementation of an undirected graph.
+ * graph is an implementation of an undirected graph,
+ * and the storage behind the Directed type.
  *
  * @author Alice McRae
  * ported to Go by Andrew Thorp
@@ -20,13 +58,18 @@ import (
  *
  *      An edge between verteces x and y is denoted
  *      as adjacencies[x][y] = true, where x > y
+ *
+ *      In a directed graph an edge from x to y is
+ *      denoted as adjacencies[x][y] = true, degrees
+ *      holds out-degrees and inDegrees in-degrees.
  */
 type Undirected struct {
-	// directed    bool // TODO: add directed functionality
+	directed    bool
 	adjacencies [][]bool    // adjacency matrix
 	edges       [][]int     // adjacency list
 	weights     [][]float64 // adjacency list
 	degrees     []int
+	inDegrees   []int // only maintained for directed graphs
 	numVertices int
 	numEdges    int
 }
@@ -44,6 +87,41 @@ func NewGraph(numVertices int) *Undirected {
 	return g
 }
 
+/**
+ * Constructor sets up the adjacency lists for a graph
+ *       from a list of edges
+ *
+ * @param num    number of vertices in the graph
+ * @param edges  vertex pairs to connect with unit weight
+ *
+ * Edges are added as by AddEdge, so out of range and
+ * repeated edges are ignored.
+ */
+func NewGraphFromEdges(numVertices int, edges [][2]int) *Undirected {
+	g := NewGraph(numVertices)
+	g.AddEdges(edges)
+	return g
+}
+
+/**
+ * Constructor sets up the adjacency lists for a graph
+ *       from a list of weighted edges
+ *
+ * @param num    number of vertices in the graph
+ * @param edges  the edges and their weights
+ *
+ * Edges are added as by AddEdgeWeight, so out of range
+ * edges are ignored and a repeated edge takes the last
+ * weight given.
+ */
+func NewWeightedGraphFromEdges(numVertices int, edges []WeightedEdge) *Undirected {
+	g := NewGraph(numVertices)
+	for _, e := range edges {
+		g.AddEdgeWeight(e.U, e.V, e.W)
+	}
+	return g
+}
+
 /**
  * Constructor sets up the adjacency lists for a graph
  *       from a file.  The file is in the format
@@ -52,12 +130,25 @@ func NewGraph(numVertices int) *Undirected {
  *               representing the edges
  *
  * @param filename  name of the input file
+ * @return the graph, or nil and the error encountered
+ *         opening or parsing the file
  */
-func NewGraphFromFile(filepath string) *Undirected {
-	g := new(Undirected)
-	g.Clear()
-	g.readFromFile(filepath)
-	return g
+func NewGraphFromFile(filepath string) (*Undirected, error) {
+	return newGraphFromPath(filepath, 2)
+}
+
+/**
+ * Constructor sets up the adjacency lists for a graph
+ *       from a file.
+ *
+ * Deprecated: NewGraphFromFile now returns the error
+ * itself; this is kept for existing callers.
+ *
+ * @param filename  name of the input file
+ * @return the graph, or nil and the error encountered
+ */
+func NewGraphFromFileE(filepath string) (*Undirected, error) {
+	return NewGraphFromFile(filepath)
 }
 
 /**
@@ -69,119 +160,285 @@ func NewGraphFromFile(filepath string) *Undirected {
  *               by the weight of the vertex pair edge
  *
  * @param filename  name of the input file
+ * @return the graph, or nil and the error encountered
+ *         opening or parsing the file
  */
-func NewWeightedGraphFromFile(filepath string) *Undirected {
-	g := new(Undirected)
-	g.Clear()
-	g.readWeightedFromFile(filepath)
-	return g
+func NewWeightedGraphFromFile(filepath string) (*Undirected, error) {
+	return newGraphFromPath(filepath, 3)
 }
 
 /**
- * Inputs adjacency lists from a file.
+ * Constructor sets up the adjacency lists for a weighted
+ *       graph from a file.
+ *
+ * Deprecated: NewWeightedGraphFromFile now returns the
+ * error itself; this is kept for existing callers.
  *
  * @param filename  name of the input file
+ * @return the graph, or nil and the error encountered
+ */
+func NewWeightedGraphFromFileE(filepath string) (*Undirected, error) {
+	return NewWeightedGraphFromFile(filepath)
+}
+
+/**
+ * Constructor sets up the adjacency lists for a graph
+ *       from a reader, such as an embedded file or a
+ *       network response.  The format is the same as
+ *       for NewGraphFromFile.
  *
- *       Reads the number of vertices and
- *       each edge from a file.  The file format is
- *       first entry: the number of vertices
- *       subsequent entries: pairs of vertices
- *                          representing the edges.
+ * @param r  the input
+ * @return the graph, or nil and the error encountered
+ *         reading or parsing the input
  */
-func (g *Undirected) readFromFile(filepath string) {
-	file, err := os.Open(filepath)
-	if err != nil {
-		fmt.Println(err)
-	}
-	f := bufio.NewScanner(file)
-	f.Split(bufio.ScanWords)
+func NewGraphFromReader(r io.Reader) (*Undirected, error) {
+	return newGraphFromReader(r, "", 2)
+}
 
-	var vertex2 int
+/**
+ * Constructor sets up the adjacency lists for a weighted
+ *       graph from a reader.  The format is the same as
+ *       for NewWeightedGraphFromFile.
+ *
+ * @param r  the input
+ * @return the graph, or nil and the error encountered
+ *         reading or parsing the input
+ */
+func NewWeightedGraphFromReader(r io.Reader) (*Undirected, error) {
+	return newGraphFromReader(r, "", 3)
+}
 
-	f.Scan()
-	g.numVertices, err = strconv.Atoi(f.Text())
-	g.Clear()
+/**
+ * Constructor sets up the adjacency lists for a graph
+ *       from a file that may or may not be weighted.
+ *       The format is that of NewGraphFromFile or of
+ *       NewWeightedGraphFromFile, chosen by whether the
+ *       first edge line has two or three entries.
+ *
+ * @param filename  name of the input file
+ * @return the graph, or nil and the error encountered
+ *
+ * Every later edge line must have the same number of
+ * entries as the first, so a file mixing weighted and
+ * unweighted edges is an error.
+ */
+func NewGraphAutoDetect(filepath string) (*Undirected, error) {
+	return newGraphFromPath(filepath, 0)
+}
 
-	for vertex1, _ := strconv.Atoi(f.Text()); vertex1 >= 0; {
+/**
+ * Constructor sets up the adjacency lists for a graph
+ *       from a file in adjacency list format
+ *       first line: the number of vertices
+ *       subsequent lines: a vertex followed by
+ *               its neighbors
+ *
+ * @param filename  name of the input file
+ * @return the graph, or nil and the error encountered
+ *
+ * Vertices without a line, or whose line lists no
+ * neighbors, are isolated. An edge may be listed at
+ * one or both of its endpoints.
+ */
+func NewGraphFromAdjListFile(filepath string) (*Undirected, error) {
+	return newAdjListGraphFromPath(filepath)
+}
 
-		f.Scan()
-		vertex1, err = strconv.Atoi(f.Text())
-		if err != nil {
-			fmt.Println(err)
-		}
+/**
+ * Constructor sets up the adjacency lists for a graph
+ *       from a reader in the adjacency list format of
+ *       NewGraphFromAdjListFile.
+ *
+ * @param r  the input
+ * @return the graph, or nil and the error encountered
+ *         reading or parsing the input
+ */
+func NewGraphFromAdjListReader(r io.Reader) (*Undirected, error) {
+	return newAdjListGraphFromReader(r, "")
+}
 
-		f.Scan()
-		vertex2, err = strconv.Atoi(f.Text())
-		if err != nil {
-			fmt.Println(err)
-		}
+/**
+ * Accessor for the degree of a vertex.
+ *
+ * @param   i  vertex in the graph
+ * @return  degree of vertex i, the out-degree
+ *          in a directed graph, or 0 if i is
+ *          not a vertex
+ */
+func (g *Undirected) Degree(i int) int {
+	if !g.hasVertex(i) {
+		return 0
+	}
+	return g.degrees[i]
+}
 
-		if vertex1 >= 0 && vertex2 >= 0 {
-			g.AddEdge(vertex1, vertex2)
-		}
+/**
+ * Accessor for the total weight of the edges at a vertex.
+ *
+ * @param   i  vertex in the graph
+ * @return  sum of the weights of the edges at vertex i,
+ *          leaving it in a directed graph, or 0 if i
+ *          is not a vertex
+ */
+func (g *Undirected) WeightedDegree(i int) float64 {
+	if !g.hasVertex(i) {
+		return 0
+	}
+	total := 0.0
+	for _, neighbor := range g.edges[i] {
+		total += g.weights[i][neighbor]
 	}
+	return total
 }
 
 /**
- * Inputs adjacency lists from a file.
+ * Accessor for the degree of a vertex.
  *
- * @param filename  name of the input file
+ * @param   i  vertex in the graph
+ * @return  degree of vertex i, or ErrVertexOutOfRange
+ *          if i is not a vertex
+ */
+func (g *Undirected) DegreeE(i int) (int, error) {
+	if !g.hasVertex(i) {
+		return 0, fmt.Errorf("%w: %d", ErrVertexOutOfRange, i)
+	}
+	return g.degrees[i], nil
+}
+
+/**
+ * Accessor for the number of edges leaving a vertex.
  *
- *       Reads the number of vertices and
- *       each edge from a file.  The file format is
- *       first entry: the number of vertices
- *       subsequent entries: pairs of vertices
- *                          representing the edges.
+ * @param   i  vertex in the graph
+ * @return  out-degree of vertex i, the degree
+ *          in an undirected graph
+ */
+func (g *Undirected) OutDegree(i int) int {
+	return g.Degree(i)
+}
+
+/**
+ * Accessor for the number of edges entering a vertex.
+ *
+ * @param   i  vertex in the graph
+ * @return  in-degree of vertex i, the degree
+ *          in an undirected graph
  */
-func (g *Undirected) readWeightedFromFile(filepath string) {
-	file, err := os.Open(filepath)
-	if err != nil {
-		fmt.Println(err)
+func (g *Undirected) InDegree(i int) int {
+	if !g.hasVertex(i) {
+		return 0
+	}
+	if g.directed {
+		return g.inDegrees[i]
 	}
-	f := bufio.NewScanner(file)
-	f.Split(bufio.ScanWords)
+	return g.degrees[i]
+}
 
-	var vertex2 int
-	var weight float64
+/**
+ * Accessor for whether a vertex is in the graph.
+ *
+ * @param   v  vertex id
+ * @return  true if 0 <= v < numVertices
+ */
+func (g *Undirected) hasVertex(v int) bool {
+	return v >= 0 && v < g.numVertices
+}
 
-	f.Scan()
-	g.numVertices, err = strconv.Atoi(f.Text())
-	g.Clear()
+/**
+ * Accessor for whether edges have a direction.
+ *
+ * @return  true if the graph was built as directed
+ */
+func (g *Undirected) IsDirected() bool {
+	return g.directed
+}
+
+/**
+ * Adds a new vertex with no edges to the graph.
+ *
+ * @return the id of the new vertex
+ *
+ * Existing vertices and edges are preserved. Each call
+ * takes O(n) time to grow the adjacency matrix.
+ */
+func (g *Undirected) AddVertex() int {
+	vertex := g.numVertices
+	g.numVertices++
 
-	for vertex1, _ := strconv.Atoi(f.Text()); vertex1 >= 0; {
+	for i := 0; i < vertex; i++ {
+		g.adjacencies[i] = append(g.adjacencies[i], false)
+		g.weights[i] = append(g.weights[i], 0)
+	}
+	g.adjacencies = append(g.adjacencies, make([]bool, g.numVertices))
+	g.weights = append(g.weights, make([]float64, g.numVertices))
+	g.edges = append(g.edges, []int{})
+	g.degrees = append(g.degrees, 0)
+	g.inDegrees = append(g.inDegrees, 0)
+
+	return vertex
+}
+
+/**
+ * Removes a vertex and every edge touching it, then
+ * renumbers the remaining vertices to fill the gap.
+ *
+ * @param v  the vertex to remove
+ * @return the new id of each surviving vertex keyed by its
+ *         old id, or nil if v is not a vertex
+ *
+ * Vertices below v keep their ids and those above it move
+ * down by one, so the relative order of ids is unchanged.
+ * This takes O(n^2) time to rebuild the adjacency matrix.
+ */
+func (g *Undirected) RemoveVertex(v int) map[int]int {
+	if !g.hasVertex(v) {
+		return nil
+	}
 
-		f.Scan()
-		vertex1, err = strconv.Atoi(f.Text())
-		if err != nil {
-			fmt.Println(err)
+	newID := func(u int) int {
+		if u > v {
+			return u - 1
 		}
+		return u
+	}
 
-		f.Scan()
-		vertex2, err = strconv.Atoi(f.Text())
-		if err != nil {
-			fmt.Println(err)
+	g.numEdges -= g.degrees[v]
+	if g.directed {
+		g.numEdges -= g.inDegrees[v]
+		for _, u := range g.edges[v] {
+			g.inDegrees[u]--
 		}
+	}
 
-		f.Scan()
-		weight, err = strconv.ParseFloat(f.Text(), 64)
-		if err != nil {
-			fmt.Println(err)
+	mapping := make(map[int]int, g.numVertices-1)
+	for u := 0; u < g.numVertices; u++ {
+		if u == v {
+			continue
 		}
+		mapping[u] = newID(u)
 
-		if vertex1 >= 0 && vertex2 >= 0 {
-			g.AddEdgeWeight(vertex1, vertex2, weight)
+		// drop column v from the matrices
+		g.adjacencies[u] = append(g.adjacencies[u][:v], g.adjacencies[u][v+1:]...)
+		g.weights[u] = append(g.weights[u][:v], g.weights[u][v+1:]...)
+
+		neighbors := g.edges[u][:0]
+		for _, neighbor := range g.edges[u] {
+			if neighbor != v {
+				neighbors = append(neighbors, newID(neighbor))
+			}
 		}
+		g.edges[u] = neighbors
+		g.degrees[u] = len(neighbors)
 	}
-}
 
-/**
- * Accessor for the degree of a vertex.
- *
- * @param   i  vertex in the graph
- * @return  degree of vertex i
- */
-func (g *Undirected) Degree(i int) int {
-	return g.degrees[i]
+	// drop row v
+	g.adjacencies = append(g.adjacencies[:v], g.adjacencies[v+1:]...)
+	g.weights = append(g.weights[:v], g.weights[v+1:]...)
+	g.edges = append(g.edges[:v], g.edges[v+1:]...)
+	g.degrees = append(g.degrees[:v], g.degrees[v+1:]...)
+	g.inDegrees = append(g.inDegrees[:v], g.inDegrees[v+1:]...)
+	g.numVertices--
+
+	return mapping
 }
 
 /**
@@ -191,10 +448,13 @@ func (g *Undirected) Degree(i int) int {
  * @param vertex2  one endpoint
  *
  * The smaller of the inputs is added to the larger
- * vertice's list
+ * vertice's list. The new edge has unit weight; an
+ * edge already in the graph keeps its weight.
  */
 func (g *Undirected) AddEdge(vertex1, vertex2 int) {
-	g.AddEdgeWeight(vertex1, vertex2, 1)
+	if !g.IsConnected(vertex1, vertex2) {
+		g.AddEdgeWeight(vertex1, vertex2, 1)
+	}
 }
 
 /**
@@ -204,28 +464,185 @@ func (g *Undirected) AddEdge(vertex1, vertex2 int) {
  * @param vertex2  one endpoint
  *
  * The smaller of the inputs is added to the larger
- * vertice's list
+ * vertice's list. In a directed graph the edge runs
+ * from vertex1 to vertex2 and is only added to
+ * vertex1's list. Edges with an endpoint outside
+ * the graph and self loops are ignored.
+ *
+ * If the edge is already in the graph only its weight
+ * is changed, as by SetWeight; the edge count and
+ * degrees are untouched. Use AddEdgeWeightChecked to
+ * be told about existing edges instead.
  */
 func (g *Undirected) AddEdgeWeight(vertex1, vertex2 int, weight float64) {
-    if vertex1 != vertex2 && !g.IsConnected(vertex1, vertex2) {
-    	g.numEdges++
-    	g.degrees[vertex1]++
-    	g.degrees[vertex2]++
+	if !g.hasVertex(vertex1) || !g.hasVertex(vertex2) {
+		return
+	}
+	if g.SetWeight(vertex1, vertex2, weight) {
+		return
+	}
+	if vertex1 != vertex2 {
+		g.numEdges++
+
+		if g.directed {
+			g.degrees[vertex1]++
+			g.inDegrees[vertex2]++
+			g.adjacencies[vertex1][vertex2] = true
+			g.weights[vertex1][vertex2] = weight
+			g.edges[vertex1] = append(g.edges[vertex1], vertex2)
+			return
+		}
 
-    	// inforce vertex1 > vertex2
-    	if vertex1 < vertex2 {
-    		temp := vertex1
-    		vertex1 = vertex2
-    		vertex2 = temp
-    	}
+		g.degrees[vertex1]++
+		g.degrees[vertex2]++
 
-    	// update
-    	g.adjacencies[vertex1][vertex2] = true
-    	g.weights[vertex1][vertex2] = weight
-    	g.weights[vertex2][vertex1] = weight
-    	g.edges[vertex1] = append(g.edges[vertex1], vertex2)
-    	g.edges[vertex2] = append(g.edges[vertex2], vertex1)
-    }
+		// inforce vertex1 > vertex2
+		if vertex1 < vertex2 {
+			temp := vertex1
+			vertex1 = vertex2
+			vertex2 = temp
+		}
+
+		// update
+		g.adjacencies[vertex1][vertex2] = true
+		g.weights[vertex1][vertex2] = weight
+		g.weights[vertex2][vertex1] = weight
+		g.edges[vertex1] = append(g.edges[vertex1], vertex2)
+		g.edges[vertex2] = append(g.edges[vertex2], vertex1)
+	}
+}
+
+/**
+ * Adds a new edge uv like AddEdgeWeight, but reports
+ * edges that could not be added instead of ignoring
+ * them, and leaves an existing edge's weight alone.
+ *
+ * @param vertex1  one endpoint
+ * @param vertex2  one endpoint
+ * @param weight   weight of the edge
+ * @return ErrVertexOutOfRange, ErrSelfLoop, or a
+ *         *DuplicateEdgeError if the edge exists
+ */
+func (g *Undirected) AddEdgeWeightChecked(vertex1, vertex2 int, weight float64) error {
+	switch {
+	case !g.hasVertex(vertex1) || !g.hasVertex(vertex2):
+		return fmt.Errorf("%w: edge (%d, %d)", ErrVertexOutOfRange, vertex1, vertex2)
+	case vertex1 == vertex2:
+		return fmt.Errorf("%w at vertex %d", ErrSelfLoop, vertex1)
+	case g.IsConnected(vertex1, vertex2):
+		return &DuplicateEdgeError{vertex1, vertex2, g.Weight(vertex1, vertex2), weight}
+	}
+	g.AddEdgeWeight(vertex1, vertex2, weight)
+	return nil
+}
+
+/**
+ * Adds many unit weight edges at once.
+ *
+ * @param pairs  the edges to add
+ *
+ * Behaves exactly like calling AddEdge on each pair, but
+ * first counts the new edges at each vertex so every
+ * adjacency list grows at most once.
+ */
+func (g *Undirected) AddEdges(pairs [][2]int) {
+	extra := make([]int, g.numVertices)
+	for _, p := range pairs {
+		if g.hasVertex(p[0]) && g.hasVertex(p[1]) && p[0] != p[1] {
+			extra[p[0]]++
+			if !g.directed {
+				extra[p[1]]++
+			}
+		}
+	}
+	for v, count := range extra {
+		if need := len(g.edges[v]) + count; need > cap(g.edges[v]) {
+			grown := make([]int, len(g.edges[v]), need)
+			copy(grown, g.edges[v])
+			g.edges[v] = grown
+		}
+	}
+
+	for _, p := range pairs {
+		g.AddEdge(p[0], p[1])
+	}
+}
+
+/**
+ * Removes the edge uv from an undirected graph.
+ *
+ * @param vertex1  one endpoint
+ * @param vertex2  one endpoint
+ *
+ * Nothing happens if the edge is not in the graph.
+ * In a directed graph only the edge from vertex1 to
+ * vertex2 is removed.
+ */
+func (g *Undirected) RemoveEdge(vertex1, vertex2 int) {
+	if vertex1 != vertex2 && g.IsConnected(vertex1, vertex2) {
+		g.numEdges--
+
+		if g.directed {
+			g.degrees[vertex1]--
+			g.inDegrees[vertex2]--
+			g.adjacencies[vertex1][vertex2] = false
+			g.weights[vertex1][vertex2] = 0
+			g.edges[vertex1] = removeNeighbor(g.edges[vertex1], vertex2)
+			return
+		}
+
+		g.degrees[vertex1]--
+		g.degrees[vertex2]--
+
+		// inforce vertex1 > vertex2
+		if vertex1 < vertex2 {
+			vertex1, vertex2 = vertex2, vertex1
+		}
+
+		// update
+		g.adjacencies[vertex1][vertex2] = false
+		g.weights[vertex1][vertex2] = 0
+		g.weights[vertex2][vertex1] = 0
+		g.edges[vertex1] = removeNeighbor(g.edges[vertex1], vertex2)
+		g.edges[vertex2] = removeNeighbor(g.edges[vertex2], vertex1)
+	}
+}
+
+/**
+ * Changes the weight of an existing edge uv.
+ *
+ * @param vertex1  one endpoint
+ * @param vertex2  one endpoint
+ * @param weight   the new weight
+ * @return false, without adding an edge, if uv is
+ *         not in the graph
+ */
+func (g *Undirected) SetWeight(vertex1, vertex2 int, weight float64) bool {
+	if !g.IsConnected(vertex1, vertex2) {
+		return false
+	}
+	g.weights[vertex1][vertex2] = weight
+	if !g.directed {
+		g.weights[vertex2][vertex1] = weight
+	}
+	return true
+}
+
+/**
+ * Removes the first occurrence of a vertex from an
+ * adjacency list, keeping the order of the rest.
+ *
+ * @param list    the adjacency list
+ * @param vertex  the vertex to remove
+ * @return the shortened list
+ */
+func removeNeighbor(list []int, vertex int) []int {
+	for i, v := range list {
+		if v == vertex {
+			return append(list[:i], list[i+1:]...)
+		}
+	}
+	return list
 }
 
 /**
@@ -233,9 +650,22 @@ func (g *Undirected) AddEdgeWeight(vertex1, vertex2 int, weight float64) {
  *
  * @param   vertex1  vertex in the graph
  * @param   vertex2  vertex in the graph
- * @return  whether or not the vertices are connected
+ * @return  whether or not the vertices are connected,
+ *          by an edge from vertex1 to vertex2 in a
+ *          directed graph. False if either is not
+ *          a vertex.
+ *
+ * This only looks for an edge between the two vertices;
+ * use IsConnectedGraph to ask whether the whole graph is
+ * connected.
  */
 func (g *Undirected) IsConnected(vertex1, vertex2 int) bool {
+	if !g.hasVertex(vertex1) || !g.hasVertex(vertex2) {
+		return false
+	}
+	if g.directed {
+		return g.adjacencies[vertex1][vertex2]
+	}
 	if vertex1 > vertex2 {
 		return g.adjacencies[vertex1][vertex2]
 	} else {
@@ -244,6 +674,18 @@ func (g *Undirected) IsConnected(vertex1, vertex2 int) bool {
 	}
 }
 
+/**
+ * Accessor for whether an edge exists, the name used by
+ * the Graph interface for IsConnected.
+ *
+ * @param   vertex1  vertex in the graph
+ * @param   vertex2  vertex in the graph
+ * @return  the same as IsConnected
+ */
+func (g *Undirected) HasEdge(vertex1, vertex2 int) bool {
+	return g.IsConnected(vertex1, vertex2)
+}
+
 /**
  * Accessor for the weight of an edge.
  *
@@ -253,12 +695,15 @@ func (g *Undirected) IsConnected(vertex1, vertex2 int) bool {
  *          if there is no connection 0
  */
 func (g *Undirected) Weight(vertex1, vertex2 int) float64 {
-
-	if g.adjacencies[vertex1][vertex2] || g.adjacencies[vertex2][vertex1]{
-		return g.weights[vertex1][vertex2]
-	} else {
+	if !g.IsConnected(vertex1, vertex2) {
 		return 0
 	}
+
+	// inforce vertex1 > vertex2 for undirected graphs
+	if !g.directed && vertex1 < vertex2 {
+		vertex1, vertex2 = vertex2, vertex1
+	}
+	return g.weights[vertex1][vertex2]
 }
 
 /**
@@ -284,12 +729,163 @@ func (g *Undirected) Size() int {
  *
  * @param the vertex whos edges are to be retrieved
  *
- * @return the adjacency list of vertex
+ * @return the adjacency list of vertex, nil if
+ *         vertex is not in the graph
+ *
+ * The returned slice is the graph's own storage, so it
+ * must not be modified or appended to; use Neighbors for
+ * a copy that is safe to change.
  */
 func (g *Undirected) GetEdges(vertex int) []int {
+	if !g.hasVertex(vertex) {
+		return nil
+	}
 	return g.edges[vertex]
 }
 
+/**
+ * Accessor for the neighbors of a vertex
+ *
+ * @param vertex  the vertex whos neighbors are to be retrieved
+ * @return a copy of the adjacency list of vertex, nil
+ *         if vertex is not in the graph
+ */
+func (g *Undirected) Neighbors(vertex int) []int {
+	if !g.hasVertex(vertex) {
+		return nil
+	}
+	return append([]int{}, g.edges[vertex]...)
+}
+
+/**
+ * Calls fn for each neighbor of a vertex with the weight
+ * of the edge to it, without copying the adjacency list.
+ *
+ * @param v   the vertex whos neighbors are visited
+ * @param fn  called per neighbor; returning false stops
+ *            the iteration early
+ *
+ * fn is never called if v is not in the graph. In a
+ * directed graph only the out-neighbors are visited.
+ */
+func (g *Undirected) ForEachNeighbor(v int, fn func(neighbor int, weight float64) bool) {
+	if !g.hasVertex(v) {
+		return
+	}
+	for _, neighbor := range g.edges[v] {
+		if !fn(neighbor, g.weights[v][neighbor]) {
+			return
+		}
+	}
+}
+
+/**
+ * WeightedEdge is an edge between vertices U and V
+ * with weight W.
+ */
+type WeightedEdge struct {
+	U int     `json:"u"`
+	V int     `json:"v"`
+	W float64 `json:"w"`
+}
+
+/**
+ * Accessor for every edge in the graph.
+ *
+ * @return each edge once as (u, v) with u < v,
+ *         sorted by u then v. In a directed graph
+ *         each edge runs from u to v and u may be
+ *         the larger vertex.
+ */
+func (g *Undirected) EdgeList() [][2]int {
+	list := make([][2]int, 0, g.numEdges)
+	for u := 0; u < g.numVertices; u++ {
+		for v := 0; v < g.numVertices; v++ {
+			if g.directed && g.adjacencies[u][v] {
+				list = append(list, [2]int{u, v})
+			} else if !g.directed && v > u && g.adjacencies[v][u] {
+				list = append(list, [2]int{u, v})
+			}
+		}
+	}
+	return list
+}
+
+/**
+ * Accessor for every edge in the graph with its weight.
+ *
+ * @return each edge once with U < V, sorted by U then V,
+ *         ordered as in EdgeList for a directed graph
+ */
+func (g *Undirected) WeightedEdgeList() []WeightedEdge {
+	list := make([]WeightedEdge, 0, g.numEdges)
+	for _, e := range g.EdgeList() {
+		list = append(list, WeightedEdge{e[0], e[1], g.weights[e[0]][e[1]]})
+	}
+	return list
+}
+
+/**
+ * Copies the graph.
+ *
+ * @return a deep copy that shares no storage with g
+ */
+func (g *Undirected) Clone() *Undirected {
+	c := &Undirected{
+		directed:    g.directed,
+		adjacencies: make([][]bool, g.numVertices),
+		edges:       make([][]int, g.numVertices),
+		weights:     make([][]float64, g.numVertices),
+		degrees:     append([]int(nil), g.degrees...),
+		inDegrees:   append([]int(nil), g.inDegrees...),
+		numVertices: g.numVertices,
+		numEdges:    g.numEdges,
+	}
+	for i := 0; i < g.numVertices; i++ {
+		c.adjacencies[i] = append([]bool(nil), g.adjacencies[i]...)
+		c.edges[i] = append([]int{}, g.edges[i]...)
+		c.weights[i] = append([]float64(nil), g.weights[i]...)
+	}
+	return c
+}
+
+// weightEpsilon is the tolerance used when comparing edge weights
+const weightEpsilon = 1e-9
+
+/**
+ * Compares two graphs structurally.
+ *
+ * @param other  the graph to compare with
+ * @return true if both graphs have the same number of
+ *         vertices, the same direction and the same edges
+ *         with weights equal to within 1e-9
+ *
+ * The order edges were added in does not matter. Two nil
+ * graphs are equal; a nil and a non-nil graph are not.
+ */
+func (g *Undirected) Equals(other *Undirected) bool {
+	if g == nil || other == nil {
+		return g == other
+	}
+	if g.directed != other.directed || g.numVertices != other.numVertices ||
+		g.numEdges != other.numEdges {
+		return false
+	}
+
+	for i := 0; i < g.numVertices; i++ {
+		for j := 0; j < g.numVertices; j++ {
+			if g.adjacencies[i][j] != other.adjacencies[i][j] {
+				return false
+			}
+			if g.adjacencies[i][j] &&
+				math.Abs(g.weights[i][j]-other.weights[i][j]) > weightEpsilon {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 /**
  * Removes all edges from the graph.
  */
@@ -297,6 +893,7 @@ func (g *Undirected) Clear() {
 	g.numEdges = 0
 
 	g.degrees = make([]int, g.numVertices)
+	g.inDegrees = make([]int, g.numVertices)
 	g.adjacencies = make([][]bool, g.numVertices)
 	g.edges = make([][]int, g.numVertices)
 	g.weights = make([][]float64, g.numVertices)
@@ -0,0 +1,164 @@
+package graphs
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCloneIsIndependent(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdgeWeight(0, 1, 2)
+	g.AddEdgeWeight(1, 2, 3)
+	original := g.Clone()
+
+	c := g.Clone()
+	c.AddEdge(2, 3)
+	c.RemoveEdge(0, 1)
+	c.SetWeight(1, 2, 7)
+
+	if !g.Equals(original) {
+		t.Errorf("changing the clone changed the original: got %v, want %v", g, original)
+	}
+	if g.Size() != 2 || g.Degree(3) != 0 || !g.IsConnected(0, 1) {
+		t.Errorf("original edges changed: %v", g.EdgeList())
+	}
+	if got := g.Weight(1, 2); got != 3 {
+		t.Errorf("original Weight(1, 2) = %g, want 3", got)
+	}
+	if !reflect.DeepEqual(g.GetEdges(1), []int{0, 2}) {
+		t.Errorf("original GetEdges(1) = %v, want [0 2]", g.GetEdges(1))
+	}
+}
+
+func TestWeightEitherOrder(t *testing.T) {
+	g := NewGraph(6)
+	g.AddEdgeWeight(2, 5, 4.5)
+
+	if got := g.Weight(2, 5); got != 4.5 {
+		t.Errorf("Weight(2, 5) = %g, want 4.5", got)
+	}
+	if got := g.Weight(5, 2); got != 4.5 {
+		t.Errorf("Weight(5, 2) = %g, want 4.5", got)
+	}
+	if got := g.Weight(1, 3); got != 0 {
+		t.Errorf("Weight(1, 3) = %g for a non-edge, want 0", got)
+	}
+	if got := g.Weight(2, 9); got != 0 {
+		t.Errorf("Weight(2, 9) = %g for a non-vertex, want 0", got)
+	}
+}
+
+func TestNeighborsIsACopy(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdge(0, 3)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	edges := append([]int(nil), g.GetEdges(0)...)
+	order := g.BFS(0)
+
+	neighbors := g.Neighbors(0)
+	sort.Ints(neighbors)
+	neighbors[0] = 99
+	_ = append(neighbors[:1], 98)
+
+	if got := g.GetEdges(0); !reflect.DeepEqual(got, edges) {
+		t.Errorf("GetEdges(0) = %v after changing Neighbors, want %v", got, edges)
+	}
+	if got := g.BFS(0); !reflect.DeepEqual(got, order) {
+		t.Errorf("BFS(0) = %v after changing Neighbors, want %v", got, order)
+	}
+}
+
+// benchmarkPairs joins each vertex to the next ten, wrapping
+// around, with a duplicate and a self loop thrown in.
+func benchmarkPairs(n int) [][2]int {
+	pairs := [][2]int{{0, 1}, {0, 0}}
+	for u := 0; u < n; u++ {
+		for k := 1; k <= 10; k++ {
+			pairs = append(pairs, [2]int{u, (u + k) % n})
+		}
+	}
+	return pairs
+}
+
+func TestAddEdgesMatchesAddEdge(t *testing.T) {
+	pairs := benchmarkPairs(50)
+	bulk, loop := NewGraph(50), NewGraph(50)
+	bulk.AddEdges(pairs)
+	for _, p := range pairs {
+		loop.AddEdge(p[0], p[1])
+	}
+	if !reflect.DeepEqual(bulk.EdgeList(), loop.EdgeList()) || bulk.Size() != loop.Size() {
+		t.Errorf("AddEdges gave %v, AddEdge gave %v", bulk.EdgeList(), loop.EdgeList())
+	}
+}
+
+func BenchmarkAddEdges(b *testing.B) {
+	pairs := benchmarkPairs(1000)
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		g := NewGraph(1000)
+		b.StartTimer()
+		g.AddEdges(pairs)
+	}
+}
+
+func BenchmarkAddEdgeLoop(b *testing.B) {
+	pairs := benchmarkPairs(1000)
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		g := NewGraph(1000)
+		b.StartTimer()
+		for _, p := range pairs {
+			g.AddEdge(p[0], p[1])
+		}
+	}
+}
+
+func TestRemoveVertex(t *testing.T) {
+	g := NewGraph(5)
+	g.AddEdgeWeight(0, 2, 1.5)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+	g.AddEdge(0, 4)
+
+	mapping := g.RemoveVertex(2)
+	if want := map[int]int{0: 0, 1: 1, 3: 2, 4: 3}; !reflect.DeepEqual(mapping, want) {
+		t.Errorf("RemoveVertex(2) mapping = %v, want %v", mapping, want)
+	}
+	if want := [][2]int{{0, 3}, {2, 3}}; !reflect.DeepEqual(g.EdgeList(), want) {
+		t.Errorf("EdgeList() = %v, want %v", g.EdgeList(), want)
+	}
+	if want := []int{1, 0, 1, 2}; !reflect.DeepEqual(g.degrees, want) {
+		t.Errorf("degrees = %v, want %v", g.degrees, want)
+	}
+	if g.Order() != 4 || g.Size() != 2 || !g.IsConnected(3, 0) || g.IsConnected(0, 2) {
+		t.Errorf("after RemoveVertex(2): %v", g)
+	}
+	if got := g.RemoveVertex(4); got != nil {
+		t.Errorf("RemoveVertex(4) = %v for a non-vertex, want nil", got)
+	}
+
+	d := NewDirectedGraph(4)
+	d.AddEdge(0, 1)
+	d.AddEdge(1, 2)
+	d.AddEdge(2, 0)
+	d.AddEdge(3, 2)
+	d.AddEdge(3, 0)
+	d.RemoveVertex(1)
+
+	if want := [][2]int{{1, 0}, {2, 0}, {2, 1}}; !reflect.DeepEqual(d.EdgeList(), want) {
+		t.Errorf("directed EdgeList() = %v, want %v", d.EdgeList(), want)
+	}
+	if want := []int{0, 1, 2}; !reflect.DeepEqual(d.degrees, want) {
+		t.Errorf("directed out-degrees = %v, want %v", d.degrees, want)
+	}
+	if want := []int{2, 1, 0}; !reflect.DeepEqual(d.inDegrees, want) {
+		t.Errorf("directed inDegrees = %v, want %v", d.inDegrees, want)
+	}
+	if d.Size() != 3 {
+		t.Errorf("directed Size() = %d, want 3", d.Size())
+	}
+}
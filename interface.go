@@ -0,0 +1,32 @@
+package graphs
+
+/**
+ * Graph is the read-only view of a graph that algorithms
+ * can be written against, so they accept an Undirected,
+ * a Directed or a SyncUndirected alike.
+ *
+ * Vertices are labeled 0..Order()-1. In a directed graph
+ * Neighbors and Degree follow outgoing edges only.
+ */
+type Graph interface {
+	// Order is the number of vertices
+	Order() int
+	// Size is the number of edges
+	Size() int
+	// IsDirected reports whether edges have a direction
+	IsDirected() bool
+	// HasEdge reports whether there is an edge from u to v
+	HasEdge(u, v int) bool
+	// Weight is the weight of the edge from u to v, 0 if none
+	Weight(u, v int) float64
+	// Neighbors is a copy of the vertices adjacent to v
+	Neighbors(v int) []int
+	// Degree is the number of edges leaving v
+	Degree(v int) int
+}
+
+var (
+	_ Graph = (*Undirected)(nil)
+	_ Graph = (*Directed)(nil)
+	_ Graph = (*SyncUndirected)(nil)
+)
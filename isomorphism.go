@@ -0,0 +1,116 @@
+package graphs
+
+/**
+ * Determines whether two graphs have the same structure
+ * up to a relabeling of their vertices.
+ *
+ * @param other  the graph to compare against
+ * @return true if some bijection between the vertices maps
+ *         the edges of g exactly onto the edges of other
+ *
+ * Edge weights are ignored, and a directed graph is never
+ * isomorphic to an undirected one. Graphs that differ in
+ * order, size or degree sequence are rejected without any
+ * search. Otherwise vertices are matched by backtracking,
+ * pairing only vertices of equal degree, which is
+ * exponential in the worst case and so only practical for
+ * graphs of a few dozen vertices.
+ */
+func (g *Undirected) IsIsomorphic(other *Undirected) bool {
+	if other == nil || g.directed != other.directed ||
+		g.numVertices != other.numVertices || g.numEdges != other.numEdges {
+		return false
+	}
+
+	gDegrees, otherDegrees := g.DegreeSequence(), other.DegreeSequence()
+	for i := range gDegrees {
+		if gDegrees[i] != otherDegrees[i] {
+			return false
+		}
+	}
+
+	order := g.matchingOrder()
+	mapping := make([]int, g.numVertices)
+	used := make([]bool, g.numVertices)
+	for i := range mapping {
+		mapping[i] = -1
+	}
+
+	// consistent reports whether u may map to x given the
+	// vertices placed before it
+	consistent := func(u, x int, placed []int) bool {
+		if g.degrees[u] != other.degrees[x] || g.InDegree(u) != other.InDegree(x) {
+			return false
+		}
+		for _, w := range placed {
+			y := mapping[w]
+			if g.IsConnected(u, w) != other.IsConnected(x, y) {
+				return false
+			}
+			if g.directed && g.IsConnected(w, u) != other.IsConnected(y, x) {
+				return false
+			}
+		}
+		return true
+	}
+
+	var match func(i int) bool
+	match = func(i int) bool {
+		if i == len(order) {
+			return true
+		}
+		u := order[i]
+		for x := 0; x < other.numVertices; x++ {
+			if used[x] || !consistent(u, x, order[:i]) {
+				continue
+			}
+			mapping[u], used[x] = x, true
+			if match(i + 1) {
+				return true
+			}
+			mapping[u], used[x] = -1, false
+		}
+		return false
+	}
+	return match(0)
+}
+
+/**
+ * Orders the vertices for the isomorphism search so that
+ * each vertex has as many neighbors as possible already
+ * placed, which lets mismatches be caught early.
+ *
+ * @return every vertex, most constrained first
+ */
+func (g *Undirected) matchingOrder() []int {
+	placed := make([]bool, g.numVertices)
+	links := make([]int, g.numVertices)
+	order := make([]int, 0, g.numVertices)
+
+	for len(order) < g.numVertices {
+		best := -1
+		for v := 0; v < g.numVertices; v++ {
+			if placed[v] {
+				continue
+			}
+			if best == -1 || links[v] > links[best] ||
+				(links[v] == links[best] && g.degrees[v] > g.degrees[best]) {
+				best = v
+			}
+		}
+
+		placed[best] = true
+		order = append(order, best)
+		for _, neighbor := range g.edges[best] {
+			links[neighbor]++
+		}
+		if g.directed {
+			for v := 0; v < g.numVertices; v++ {
+				if g.adjacencies[v][best] {
+					links[v]++
+				}
+			}
+		}
+	}
+	return order
+}
@@ -0,0 +1,37 @@
+package graphs
+
+import "testing"
+
+func TestIsIsomorphic(t *testing.T) {
+	cycle := CycleGraph(6)
+	// the same hexagon with its vertices shuffled
+	relabeled := NewGraphFromEdges(6, [][2]int{{3, 0}, {0, 5}, {5, 1}, {1, 4}, {4, 2}, {2, 3}})
+	if !cycle.IsIsomorphic(relabeled) {
+		t.Error("relabeled hexagon is not isomorphic to CycleGraph(6)")
+	}
+
+	// every vertex has degree 2 in both, but one is connected
+	triangles := NewGraphFromEdges(6, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}})
+	if cycle.IsIsomorphic(triangles) || triangles.IsIsomorphic(cycle) {
+		t.Error("hexagon is isomorphic to two triangles")
+	}
+
+	path := NewDirectedGraph(3)
+	path.AddEdge(0, 1)
+	path.AddEdge(1, 2)
+	reversed := NewDirectedGraph(3)
+	reversed.AddEdge(2, 0)
+	reversed.AddEdge(0, 1)
+	if !path.IsIsomorphic(reversed.Undirected) {
+		t.Error("directed paths 0->1->2 and 2->0->1 are not isomorphic")
+	}
+	star := NewDirectedGraph(3)
+	star.AddEdge(0, 1)
+	star.AddEdge(0, 2)
+	if path.IsIsomorphic(star.Undirected) {
+		t.Error("directed path is isomorphic to an out-star")
+	}
+	if PathGraph(3).IsIsomorphic(path.Undirected) {
+		t.Error("undirected path is isomorphic to a directed one")
+	}
+}
@@ -0,0 +1,111 @@
+package graphs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+/**
+ * graphJSON is the serialized form of a graph.
+ */
+type graphJSON struct {
+	Vertices int            `json:"vertices"`
+	Directed bool           `json:"directed,omitempty"`
+	Edges    []WeightedEdge `json:"edges"`
+}
+
+/**
+ * Encodes the graph as JSON.
+ *
+ * @return an object holding the vertex count, whether the
+ *         graph is directed, and each edge once with its
+ *         weight, e.g. {"vertices":2,"edges":[{"u":0,"v":1,"w":1}]}
+ */
+func (g *Undirected) MarshalJSON() ([]byte, error) {
+	return json.Marshal(graphJSON{
+		Vertices: g.numVertices,
+		Directed: g.directed,
+		Edges:    g.WeightedEdgeList(),
+	})
+}
+
+/**
+ * Decodes an undirected graph written by MarshalJSON,
+ * replacing the contents of g.
+ *
+ * @param data  the JSON encoding
+ * @return any decoding error, ErrDirected if the encoding
+ *         is of a directed graph, or an error for a vertex
+ *         count below 0 or above MaxInputVertices or an edge
+ *         outside the graph
+ *
+ * The graph is rebuilt with AddEdgeWeight so its degrees
+ * and edge count are always consistent. Decode a directed
+ * graph into a Directed instead.
+ */
+func (g *Undirected) UnmarshalJSON(data []byte) error {
+	return g.unmarshal(data, false)
+}
+
+/**
+ * Decodes a directed graph written by MarshalJSON,
+ * replacing the contents of d.
+ *
+ * @param data  the JSON encoding
+ * @return any decoding error, ErrNotDirected if the
+ *         encoding is of an undirected graph, or an error
+ *         for a vertex count below 0 or above
+ *         MaxInputVertices or an edge outside the graph
+ *
+ * A zero Directed is given a graph to decode into.
+ */
+func (d *Directed) UnmarshalJSON(data []byte) error {
+	if d.Undirected == nil {
+		d.Undirected = new(Undirected)
+	}
+	return d.unmarshal(data, true)
+}
+
+/**
+ * Decodes a graph of the expected direction, shared by
+ * the UnmarshalJSON methods.
+ *
+ * @param data      the JSON encoding
+ * @param directed  whether the encoding must be of a
+ *                  directed graph
+ * @return any decoding or validation error; g is left
+ *         unchanged on error
+ */
+func (g *Undirected) unmarshal(data []byte, directed bool) error {
+	var decoded graphJSON
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	if decoded.Directed && !directed {
+		return fmt.Errorf("%w: decode it into a Directed", ErrDirected)
+	}
+	if !decoded.Directed && directed {
+		return fmt.Errorf("%w: decode it into an Undirected", ErrNotDirected)
+	}
+	if decoded.Vertices < 0 {
+		return fmt.Errorf("graphs: negative vertex count %d", decoded.Vertices)
+	}
+	if decoded.Vertices > MaxInputVertices {
+		return fmt.Errorf("graphs: vertex count %d exceeds MaxInputVertices %d",
+			decoded.Vertices, MaxInputVertices)
+	}
+	for _, e := range decoded.Edges {
+		if e.U < 0 || e.U >= decoded.Vertices || e.V < 0 || e.V >= decoded.Vertices {
+			return fmt.Errorf("%w: edge (%d, %d) in graph of %d vertices",
+				ErrVertexOutOfRange, e.U, e.V, decoded.Vertices)
+		}
+	}
+
+	g.directed = directed
+	g.numVertices = decoded.Vertices
+	g.Clear()
+	for _, e := range decoded.Edges {
+		g.AddEdgeWeight(e.U, e.V, e.W)
+	}
+	return nil
+}
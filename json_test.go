@@ -0,0 +1,53 @@
+package graphs
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	g := NewGraph(3)
+	g.AddEdgeWeight(0, 2, 1.5)
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Undirected
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equals(g) {
+		t.Errorf("decoded %s as %v, want %v", data, &decoded, g)
+	}
+}
+
+func TestJSONRoundTripDirected(t *testing.T) {
+	d := NewDirectedGraph(3)
+	d.AddEdgeWeight(2, 0, 1.5)
+	data, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Directed
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equals(d.Undirected) {
+		t.Errorf("decoded %s as %v, want %v", data, decoded.Undirected, d.Undirected)
+	}
+
+	var undirected Undirected
+	if err := json.Unmarshal(data, &undirected); !errors.Is(err, ErrDirected) {
+		t.Errorf("decoding %s into an Undirected gave %v, want ErrDirected", data, err)
+	}
+}
+
+func TestJSONDirectedRejectsUndirected(t *testing.T) {
+	var d Directed
+	err := json.Unmarshal([]byte(`{"vertices":2,"edges":[{"u":0,"v":1,"w":1}]}`), &d)
+	if !errors.Is(err, ErrNotDirected) {
+		t.Errorf("decoding an undirected graph into a Directed gave %v, want ErrNotDirected", err)
+	}
+}
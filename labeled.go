@@ -0,0 +1,145 @@
+package graphs
+
+/**
+ * LabeledGraph names the vertices of an undirected graph
+ * with strings. Each new name is given the next integer id,
+ * so the underlying graph can still be used directly by the
+ * integer based algorithms.
+ */
+type LabeledGraph struct {
+	graph  *Undirected
+	labels []string
+	ids    map[string]int
+}
+
+/**
+ * Constructor sets up a labeled graph with no vertices.
+ */
+func NewLabeledGraph() *LabeledGraph {
+	return &LabeledGraph{graph: NewGraph(0), ids: map[string]int{}}
+}
+
+/**
+ * Accessor for the underlying graph, which is shared rather
+ * than copied so algorithms see its edges as they change.
+ *
+ * @return the integer based graph
+ *
+ * Its edges may be changed freely, but vertices must only
+ * be added or removed through AddLabel and RemoveLabel.
+ * Calling AddVertex or RemoveVertex on it directly leaves
+ * ids without names or renumbers them behind the labels.
+ */
+func (l *LabeledGraph) Graph() *Undirected {
+	return l.graph
+}
+
+/**
+ * Adds a named vertex if the name is new.
+ *
+ * @param name  the vertex name
+ * @return the id of the vertex with that name
+ */
+func (l *LabeledGraph) AddLabel(name string) int {
+	if id, ok := l.ids[name]; ok {
+		return id
+	}
+	id := l.graph.AddVertex()
+	l.ids[name] = id
+	l.labels = append(l.labels, name)
+	return id
+}
+
+/**
+ * Removes a named vertex and its edges.
+ *
+ * @param name  the vertex name
+ * @return false if no vertex has that name
+ *
+ * As with RemoveVertex, the vertices with larger ids move
+ * down by one, and their names follow them.
+ */
+func (l *LabeledGraph) RemoveLabel(name string) bool {
+	id, ok := l.ids[name]
+	if !ok {
+		return false
+	}
+	l.graph.RemoveVertex(id)
+	delete(l.ids, name)
+	l.labels = append(l.labels[:id], l.labels[id+1:]...)
+	for v := id; v < len(l.labels); v++ {
+		l.ids[l.labels[v]] = v
+	}
+	return true
+}
+
+/**
+ * Adds a unit weight edge between two named vertices,
+ * adding the vertices first if the names are new.
+ *
+ * @param a  one endpoint
+ * @param b  one endpoint
+ *
+ * As with AddEdge, an existing edge keeps its weight.
+ */
+func (l *LabeledGraph) AddLabeledEdge(a, b string) {
+	l.graph.AddEdge(l.AddLabel(a), l.AddLabel(b))
+}
+
+/**
+ * Adds a weighted edge between two named vertices,
+ * adding the vertices first if the names are new.
+ *
+ * @param a       one endpoint
+ * @param b       one endpoint
+ * @param weight  weight of the edge
+ *
+ * As with AddEdgeWeight, an existing edge takes the
+ * new weight.
+ */
+func (l *LabeledGraph) AddLabeledEdgeWeight(a, b string, weight float64) {
+	l.graph.AddEdgeWeight(l.AddLabel(a), l.AddLabel(b), weight)
+}
+
+/**
+ * Accessor for the name of a vertex.
+ *
+ * @param v  vertex id
+ * @return the name of v, or "" if v is not a vertex
+ */
+func (l *LabeledGraph) LabelOf(v int) string {
+	if v < 0 || v >= len(l.labels) {
+		return ""
+	}
+	return l.labels[v]
+}
+
+/**
+ * Accessor for the id of a named vertex.
+ *
+ * @param name  the vertex name
+ * @return the id, and false if no vertex has that name
+ */
+func (l *LabeledGraph) IndexOf(name string) (int, bool) {
+	id, ok := l.ids[name]
+	return id, ok
+}
+
+/**
+ * Accessor for the neighbors of a named vertex.
+ *
+ * @param name  the vertex name
+ * @return the names of its neighbors in id order, nil if
+ *         no vertex has that name
+ */
+func (l *LabeledGraph) Neighbors(name string) []string {
+	id, ok := l.ids[name]
+	if !ok {
+		return nil
+	}
+	names := []string{}
+	for _, neighbor := range l.graph.sortedEdges(id) {
+		names = append(names, l.labels[neighbor])
+	}
+	return names
+}
@@ -0,0 +1,41 @@
+package graphs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLabeledGraphRemoveLabel(t *testing.T) {
+	l := NewLabeledGraph()
+	l.AddLabeledEdge("alice", "bob")
+	l.AddLabeledEdge("bob", "carol")
+	l.AddLabeledEdge("carol", "dave")
+
+	if !l.RemoveLabel("bob") {
+		t.Fatal("RemoveLabel(bob) = false")
+	}
+	if l.RemoveLabel("bob") {
+		t.Error("RemoveLabel(bob) = true a second time")
+	}
+	if _, ok := l.IndexOf("bob"); ok {
+		t.Error("IndexOf(bob) found a removed vertex")
+	}
+
+	for id, name := range []string{"alice", "carol", "dave"} {
+		if got, ok := l.IndexOf(name); !ok || got != id {
+			t.Errorf("IndexOf(%s) = %d, %v, want %d", name, got, ok, id)
+		}
+		if got := l.LabelOf(id); got != name {
+			t.Errorf("LabelOf(%d) = %s, want %s", id, got, name)
+		}
+	}
+	if got := l.Neighbors("carol"); !reflect.DeepEqual(got, []string{"dave"}) {
+		t.Errorf("Neighbors(carol) = %v, want [dave]", got)
+	}
+	if got := l.Neighbors("alice"); len(got) != 0 {
+		t.Errorf("Neighbors(alice) = %v, want none", got)
+	}
+	if got := l.Graph().Order(); got != 3 {
+		t.Errorf("Graph().Order() = %d, want 3", got)
+	}
+}
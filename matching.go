@@ -0,0 +1,142 @@
+package graphs
+
+/**
+ * Finds a maximum matching in a bipartite graph using the
+ * Hopcroft-Karp algorithm.
+ *
+ * @return the matched edges as (left, right) pairs sorted by
+ *         left, where left vertices have color 0 in the
+ *         coloring from IsBipartite; nil if the graph is
+ *         not bipartite
+ *
+ * Edge directions are ignored, so an edge of a directed
+ * graph can be matched whichever way it points.
+ */
+func (g *Undirected) MaximumBipartiteMatching() [][2]int {
+	bipartite, colors := g.IsBipartite()
+	if !bipartite {
+		return nil
+	}
+	side := make([]int, g.numVertices)
+	for v, color := range colors {
+		side[v] = color + 1
+	}
+	return g.hopcroftKarp(side)
+}
+
+/**
+ * Finds a maximum matching between two given sets of
+ * vertices using the Hopcroft-Karp algorithm.
+ *
+ * @param left   the vertices on one side
+ * @param right  the vertices on the other side
+ * @return the matched edges as (left, right) pairs sorted
+ *         by left
+ *
+ * Only edges from a left vertex to a right vertex can be
+ * matched, so the graph need not be bipartite. Edge
+ * directions are ignored. Ids outside the graph are
+ * ignored, as is any vertex given on both sides.
+ */
+func (g *Undirected) MaximumBipartiteMatchingBetween(left, right []int) [][2]int {
+	side := make([]int, g.numVertices)
+	for _, v := range left {
+		if g.hasVertex(v) {
+			side[v] = 1
+		}
+	}
+	for _, v := range right {
+		if !g.hasVertex(v) {
+			continue
+		}
+		if side[v] == 1 {
+			side[v] = -1
+		} else if side[v] == 0 {
+			side[v] = 2
+		}
+	}
+	return g.hopcroftKarp(side)
+}
+
+/**
+ * Hopcroft-Karp matching shared by the bipartite matching
+ * methods, taking O(m sqrt(n)) time.
+ *
+ * @param side  1 for left vertices, 2 for right vertices,
+ *              anything else for vertices to leave out
+ * @return the matched (left, right) pairs sorted by left
+ */
+func (g *Undirected) hopcroftKarp(side []int) [][2]int {
+	if g.directed {
+		return g.underlying().hopcroftKarp(side)
+	}
+	match := make([]int, g.numVertices)
+	for v := range match {
+		match[v] = -1
+	}
+	dist := make([]int, g.numVertices)
+
+	// layers finds the shortest augmenting path length,
+	// labeling left vertices by alternating path distance
+	// from a free left vertex
+	layers := func() bool {
+		queue := []int{}
+		for v := range dist {
+			dist[v] = -1
+			if side[v] == 1 && match[v] == -1 {
+				dist[v] = 0
+				queue = append(queue, v)
+			}
+		}
+		found := false
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, r := range g.edges[u] {
+				if side[r] != 2 {
+					continue
+				}
+				if match[r] == -1 {
+					found = true
+				} else if dist[match[r]] == -1 {
+					dist[match[r]] = dist[u] + 1
+					queue = append(queue, match[r])
+				}
+			}
+		}
+		return found
+	}
+
+	var augment func(u int) bool
+	augment = func(u int) bool {
+		for _, r := range g.edges[u] {
+			if side[r] != 2 {
+				continue
+			}
+			if w := match[r]; w == -1 || (dist[w] == dist[u]+1 && augment(w)) {
+				match[u] = r
+				match[r] = u
+				return true
+			}
+		}
+		// no augmenting path runs through u in this phase
+		dist[u] = -1
+		return false
+	}
+
+	for layers() {
+		for v := range match {
+			if side[v] == 1 && match[v] == -1 {
+				augment(v)
+			}
+		}
+	}
+
+	pairs := [][2]int{}
+	for v := range match {
+		if side[v] == 1 && match[v] != -1 {
+			pairs = append(pairs, [2]int{v, match[v]})
+		}
+	}
+	return pairs
+}
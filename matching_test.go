@@ -0,0 +1,43 @@
+package graphs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMaximumBipartiteMatching(t *testing.T) {
+	// 1 can only take 4, which forces 0 onto 5, 2 onto 6 and 3 onto 7
+	g := NewGraphFromEdges(8, [][2]int{
+		{0, 4}, {0, 5}, {1, 4}, {2, 5}, {2, 6}, {3, 6}, {3, 7},
+	})
+	want := [][2]int{{0, 5}, {1, 4}, {2, 6}, {3, 7}}
+	if got := g.MaximumBipartiteMatching(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MaximumBipartiteMatching() = %v, want %v", got, want)
+	}
+
+	if got := CycleGraph(5).MaximumBipartiteMatching(); got != nil {
+		t.Errorf("MaximumBipartiteMatching() = %v on an odd cycle, want nil", got)
+	}
+}
+
+func TestMaximumBipartiteMatchingBetween(t *testing.T) {
+	g := CycleGraph(5)
+	matching := g.MaximumBipartiteMatchingBetween([]int{0, 2}, []int{1, 3, 4})
+	if len(matching) != 2 {
+		t.Fatalf("matching %v has %d edges, want 2", matching, len(matching))
+	}
+	used := map[int]bool{}
+	for _, e := range matching {
+		if !g.IsConnected(e[0], e[1]) || used[e[0]] || used[e[1]] {
+			t.Errorf("matching %v is not a set of disjoint edges", matching)
+		}
+		used[e[0]], used[e[1]] = true, true
+	}
+
+	// 1 is on both sides so it is left out, and 7 is not a vertex
+	k := CompleteGraph(4)
+	want := [][2]int{{0, 2}}
+	if got := k.MaximumBipartiteMatchingBetween([]int{0, 1}, []int{1, 2, 7}); !reflect.DeepEqual(got, want) {
+		t.Errorf("MaximumBipartiteMatchingBetween() = %v, want %v", got, want)
+	}
+}
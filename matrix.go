@@ -0,0 +1,57 @@
+package graphs
+
+/**
+ * Exports the weighted adjacency matrix.
+ *
+ * @return a new n x n matrix where [i][j] is the weight
+ *         of the edge between i and j, or 0 if there is
+ *         none; symmetric for an undirected graph
+ */
+func (g *Undirected) AdjacencyMatrix() [][]float64 {
+	matrix := make([][]float64, g.numVertices)
+	for i := range matrix {
+		matrix[i] = make([]float64, g.numVertices)
+		for j := range matrix[i] {
+			matrix[i][j] = g.Weight(i, j)
+		}
+	}
+	return matrix
+}
+
+/**
+ * Exports the adjacency matrix.
+ *
+ * @return a new n x n matrix where [i][j] is true if
+ *         there is an edge between i and j
+ */
+func (g *Undirected) BooleanAdjacencyMatrix() [][]bool {
+	matrix := make([][]bool, g.numVertices)
+	for i := range matrix {
+		matrix[i] = make([]bool, g.numVertices)
+		for j := range matrix[i] {
+			matrix[i][j] = g.IsConnected(i, j)
+		}
+	}
+	return matrix
+}
+
+/**
+ * Exports the Laplacian matrix L = D - A.
+ *
+ * @return a new n x n matrix with the negated edge
+ *         weights off the diagonal and each vertex's
+ *         WeightedDegree on the diagonal, so every
+ *         row sums to zero
+ */
+func (g *Undirected) LaplacianMatrix() [][]float64 {
+	laplacian := g.AdjacencyMatrix()
+	for i, row := range laplacian {
+		for j, weight := range row {
+			if weight != 0 {
+				row[j] = -weight
+			}
+		}
+		row[i] = g.WeightedDegree(i)
+	}
+	return laplacian
+}
@@ -0,0 +1,62 @@
+package graphs
+
+import (
+	"math/rand"
+)
+
+/**
+ * Estimates a minimum cut using Karger's randomized
+ * contraction algorithm.
+ *
+ * @param rng         source of randomness
+ * @param iterations  number of independent trials, at least
+ *                    one trial is always run
+ * @return the number of edges in the smallest cut found,
+ *         and those edges as (u, v) with u < v
+ *
+ * Each trial contracts uniformly random edges until two
+ * super vertices remain, counting parallel edges the way
+ * repeated ContractEdge calls on a multigraph would. The
+ * contractions are tracked with a disjoint set, so a trial
+ * takes O(m) time. A single trial finds a minimum cut with
+ * probability at least 2/(n(n-1)), so use on the order of
+ * n^2 log n iterations for a reliable answer. Weights are
+ * ignored. A disconnected graph has a cut of size 0, and a
+ * graph with fewer than two vertices or a directed graph
+ * has no cut at all.
+ */
+func (g *Undirected) MinCut(rng *rand.Rand, iterations int) (int, [][2]int) {
+	if g.directed || g.numVertices < 2 {
+		return 0, nil
+	}
+	if g.NumComponents() > 1 {
+		return 0, [][2]int{}
+	}
+
+	edges := g.EdgeList()
+	var best [][2]int
+	for i := 0; i < iterations || best == nil; i++ {
+		order := rng.Perm(len(edges))
+		sets := NewDisjointSet(g.numVertices)
+		remaining := g.numVertices
+		for _, e := range order {
+			if remaining == 2 {
+				break
+			}
+			if sets.Union(edges[e][0], edges[e][1]) {
+				remaining--
+			}
+		}
+
+		cut := [][2]int{}
+		for _, e := range edges {
+			if !sets.Connected(e[0], e[1]) {
+				cut = append(cut, e)
+			}
+		}
+		if best == nil || len(cut) < len(best) {
+			best = cut
+		}
+	}
+	return len(best), best
+}
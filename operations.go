@@ -0,0 +1,198 @@
+package graphs
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrMismatchedGraphs is returned when combining graphs on different vertex sets
+var ErrMismatchedGraphs = errors.New("graphs: graphs differ in vertex count or direction")
+
+/**
+ * Builds the subgraph induced by a set of vertices.
+ *
+ * @param vertices  the vertices to keep
+ * @return a new graph on the kept vertices relabeled
+ *         0..k-1 with every edge among them and its
+ *         weight, and the original id of each new vertex
+ *
+ * Vertices keep the order they are given in. Duplicates
+ * and ids outside the graph are ignored.
+ */
+func (g *Undirected) InducedSubgraph(vertices []int) (*Undirected, []int) {
+	newID := make(map[int]int, len(vertices))
+	original := []int{}
+	for _, v := range vertices {
+		if _, seen := newID[v]; seen || !g.hasVertex(v) {
+			continue
+		}
+		newID[v] = len(original)
+		original = append(original, v)
+	}
+
+	sub := g.emptyLike(len(original))
+	for i, v := range original {
+		for _, neighbor := range g.edges[v] {
+			if j, ok := newID[neighbor]; ok {
+				sub.AddEdgeWeight(i, j, g.weights[v][neighbor])
+			}
+		}
+	}
+	return sub, original
+}
+
+/**
+ * Builds the complement of the graph.
+ *
+ * @return a new graph on the same vertices with a unit
+ *         weight edge between two distinct vertices
+ *         exactly when g has no edge between them
+ */
+func (g *Undirected) Complement() *Undirected {
+	c := g.emptyLike(g.numVertices)
+	for u := 0; u < g.numVertices; u++ {
+		for v := 0; v < g.numVertices; v++ {
+			if u == v || (!g.directed && v < u) {
+				continue
+			}
+			if !g.IsConnected(u, v) {
+				c.AddEdge(u, v)
+			}
+		}
+	}
+	return c
+}
+
+/**
+ * Combines two graphs on the same vertices, keeping every
+ * edge found in either.
+ *
+ * @param other  a graph with the same vertices and direction
+ * @return the union, or ErrMismatchedGraphs if other is nil
+ *         or differs in vertex count or direction
+ *
+ * An edge in both graphs keeps the smaller of its weights.
+ */
+func (g *Undirected) Union(other *Undirected) (*Undirected, error) {
+	if other == nil || g.directed != other.directed || g.numVertices != other.numVertices {
+		return nil, ErrMismatchedGraphs
+	}
+
+	union := g.emptyLike(g.numVertices)
+	for _, e := range g.WeightedEdgeList() {
+		if other.IsConnected(e.U, e.V) {
+			e.W = math.Min(e.W, other.Weight(e.U, e.V))
+		}
+		union.AddEdgeWeight(e.U, e.V, e.W)
+	}
+	for _, e := range other.WeightedEdgeList() {
+		if !union.IsConnected(e.U, e.V) {
+			union.AddEdgeWeight(e.U, e.V, e.W)
+		}
+	}
+	return union, nil
+}
+
+/**
+ * Combines two graphs on the same vertices, keeping only
+ * the edges found in both.
+ *
+ * @param other  a graph with the same vertices and direction
+ * @return the intersection, or ErrMismatchedGraphs as for
+ *         Union
+ *
+ * Like Union, each edge keeps the smaller of its weights.
+ */
+func (g *Undirected) Intersection(other *Undirected) (*Undirected, error) {
+	if other == nil || g.directed != other.directed || g.numVertices != other.numVertices {
+		return nil, ErrMismatchedGraphs
+	}
+
+	intersection := g.emptyLike(g.numVertices)
+	for _, e := range g.WeightedEdgeList() {
+		if other.IsConnected(e.U, e.V) {
+			intersection.AddEdgeWeight(e.U, e.V, math.Min(e.W, other.Weight(e.U, e.V)))
+		}
+	}
+	return intersection, nil
+}
+
+/**
+ * Contracts the edge uv, merging its endpoints.
+ *
+ * @param u  one endpoint
+ * @param v  one endpoint
+ * @return a new graph with one vertex fewer, or nil if uv
+ *         is not an edge
+ *
+ * The merged vertex takes the smaller id of u and v, and
+ * the vertices above the larger id move down by one. The
+ * edge uv itself is dropped rather than becoming a self
+ * loop, and an edge from both u and v to the same neighbor
+ * becomes one edge whose weight is the sum of the two.
+ */
+func (g *Undirected) ContractEdge(u, v int) *Undirected {
+	if !g.IsConnected(u, v) {
+		return nil
+	}
+	kept, removed := minInt(u, v), maxInt(u, v)
+	relabel := func(x int) int {
+		switch {
+		case x == removed:
+			return kept
+		case x > removed:
+			return x - 1
+		}
+		return x
+	}
+
+	contracted := g.emptyLike(g.numVertices - 1)
+	for _, e := range g.WeightedEdgeList() {
+		x, y := relabel(e.U), relabel(e.V)
+		if x == y {
+			continue
+		}
+		if contracted.IsConnected(x, y) {
+			contracted.SetWeight(x, y, contracted.Weight(x, y)+e.W)
+		} else {
+			contracted.AddEdgeWeight(x, y, e.W)
+		}
+	}
+	return contracted
+}
+
+/**
+ * Constructor for an edgeless graph with the same direction as g.
+ *
+ * @param numVertices  number of vertices in the new graph
+ */
+func (g *Undirected) emptyLike(numVertices int) *Undirected {
+	if g.directed {
+		return NewDirectedGraph(numVertices).Undirected
+	}
+	return NewGraph(numVertices)
+}
+
+/**
+ * Drops the edge directions, for the methods that work on
+ * the underlying undirected graph of a directed one.
+ *
+ * @return g itself if it is undirected, otherwise a new
+ *         undirected graph joining every two vertices with
+ *         an edge between them in either direction, weighted
+ *         by the lighter edge if there is one each way
+ */
+func (g *Undirected) underlying() *Undirected {
+	if !g.directed {
+		return g
+	}
+	u := NewGraph(g.numVertices)
+	for x := 0; x < g.numVertices; x++ {
+		for _, y := range g.edges[x] {
+			if !u.IsConnected(x, y) || g.weights[x][y] < u.weights[x][y] {
+				u.AddEdgeWeight(x, y, g.weights[x][y])
+			}
+		}
+	}
+	return u
+}
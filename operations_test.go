@@ -0,0 +1,50 @@
+package graphs
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestUnionIntersection(t *testing.T) {
+	g := NewGraph(4)
+	g.AddEdgeWeight(0, 1, 3)
+	g.AddEdgeWeight(1, 2, 1)
+	other := NewGraph(4)
+	other.AddEdgeWeight(1, 0, 2)
+	other.AddEdgeWeight(2, 3, 5)
+
+	union, err := g.Union(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []WeightedEdge{{0, 1, 2}, {1, 2, 1}, {2, 3, 5}}
+	if got := union.WeightedEdgeList(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+
+	intersection, err := g.Intersection(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = []WeightedEdge{{0, 1, 2}}
+	if got := intersection.WeightedEdgeList(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersection() = %v, want %v", got, want)
+	}
+}
+
+func TestUnionIntersectionMismatched(t *testing.T) {
+	g := NewGraph(3)
+	for name, other := range map[string]*Undirected{
+		"vertex count": NewGraph(4),
+		"direction":    NewDirectedGraph(3).Undirected,
+		"nil":          nil,
+	} {
+		if result, err := g.Union(other); result != nil || !errors.Is(err, ErrMismatchedGraphs) {
+			t.Errorf("Union with mismatched %s = %v, %v, want nil, ErrMismatchedGraphs", name, result, err)
+		}
+		if result, err := g.Intersection(other); result != nil || !errors.Is(err, ErrMismatchedGraphs) {
+			t.Errorf("Intersection with mismatched %s = %v, %v, want nil, ErrMismatchedGraphs", name, result, err)
+		}
+	}
+}
@@ -0,0 +1,549 @@
+package graphs
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrNegativeCycle is matched by a *NegativeCycleError using errors.Is
+var ErrNegativeCycle = errors.New("graphs: negative weight cycle")
+
+/**
+ * Finds the cheapest path between two vertices using
+ * Dijkstra's algorithm over the edge weights.
+ *
+ * @param source  the vertex the path starts at
+ * @param dest    the vertex the path ends at
+ * @return the vertices along the path, source first,
+ *         and the total weight of the path
+ *
+ * If dest cannot be reached, or either vertex is not in the
+ * graph, the path is nil and the cost is +Inf. Dijkstra's
+ * algorithm cannot handle negative weights, so if a negative
+ * edge is reached during the search the path is nil and the
+ * cost is NaN. Graphs built with AddEdge have unit weights,
+ * so the cost is the number of hops.
+ */
+func (g *Undirected) ShortestPath(source, dest int) ([]int, float64) {
+	if dest < 0 || dest >= g.numVertices {
+		return nil, math.Inf(1)
+	}
+	dist, prev, ok := g.dijkstra(source, dest)
+	if !ok {
+		return nil, math.NaN()
+	}
+	if dist == nil || math.IsInf(dist[dest], 1) {
+		return nil, math.Inf(1)
+	}
+	return pathTo(prev, dest), dist[dest]
+}
+
+/**
+ * Finds the cheapest paths from one vertex to every other
+ * using Dijkstra's algorithm over the edge weights.
+ *
+ * @param source  the vertex the paths start at
+ * @return the cost of the cheapest path to each vertex,
+ *         +Inf if it cannot be reached, and the vertex
+ *         before each one on its path, -1 for source and
+ *         unreached vertices
+ *
+ * A path is read back from the predecessors starting at
+ * its last vertex. Both slices are nil if source is not a
+ * vertex, or if a negative edge weight is reached, since
+ * Dijkstra's algorithm cannot handle them. The search uses
+ * a binary heap, taking O((n + m) log n) time.
+ */
+func (g *Undirected) ShortestPaths(source int) ([]float64, []int) {
+	dist, prev, ok := g.dijkstra(source, -1)
+	if !ok {
+		return nil, nil
+	}
+	return dist, prev
+}
+
+/**
+ * Finds the cheapest path between two vertices using A*
+ * search guided by a heuristic.
+ *
+ * @param source     the vertex the path starts at
+ * @param dest       the vertex the path ends at
+ * @param heuristic  estimated cost from a vertex to dest,
+ *                   nil for none
+ * @return the vertices along the path, source first,
+ *         and the total weight of the path
+ *
+ * The heuristic must never overestimate the remaining cost
+ * for the path to be optimal, e.g. straight line distance
+ * on a geometric graph. With a nil heuristic, or one that
+ * is always zero, this is Dijkstra's algorithm. Results
+ * for unreachable, invalid or negatively weighted input
+ * match ShortestPath.
+ */
+func (g *Undirected) AStar(source, dest int, heuristic func(v int) float64) ([]int, float64) {
+	if !g.hasVertex(source) || !g.hasVertex(dest) {
+		return nil, math.Inf(1)
+	}
+	if heuristic == nil {
+		heuristic = func(v int) float64 { return 0 }
+	}
+
+	cost := make([]float64, g.numVertices)
+	prev := make([]int, g.numVertices)
+	for i := range cost {
+		cost[i] = math.Inf(1)
+		prev[i] = -1
+	}
+
+	cost[source] = 0
+	queue := &vertexQueue{{source, heuristic(source)}}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(vertexItem)
+		v := item.vertex
+		if item.priority > cost[v]+heuristic(v) {
+			continue
+		}
+		if v == dest {
+			return pathTo(prev, dest), cost[dest]
+		}
+
+		for _, neighbor := range g.edges[v] {
+			weight := g.weights[v][neighbor]
+			if weight < 0 {
+				return nil, math.NaN()
+			}
+			if alt := cost[v] + weight; alt < cost[neighbor] {
+				cost[neighbor] = alt
+				prev[neighbor] = v
+				heap.Push(queue, vertexItem{neighbor, alt + heuristic(neighbor)})
+			}
+		}
+	}
+	return nil, math.Inf(1)
+}
+
+/**
+ * Finds the cheapest path between two vertices using A*
+ * search guided by a heuristic.
+ *
+ * Deprecated: use AStar, which also accepts a nil
+ * heuristic.
+ *
+ * @param source     the vertex the path starts at
+ * @param dest       the vertex the path ends at
+ * @param heuristic  estimated cost from a vertex to dest
+ * @return the vertices along the path, source first,
+ *         and the total weight of the path
+ */
+func (g *Undirected) AStarPath(source, dest int, heuristic func(v int) float64) ([]int, float64) {
+	return g.AStar(source, dest, heuristic)
+}
+
+/**
+ * Counts the distinct cheapest paths between two vertices.
+ *
+ * @param source  the vertex the paths start at
+ * @param dest    the vertex the paths end at
+ * @return the cost of the cheapest path and the number of
+ *         paths with that cost, +Inf and 0 if dest cannot
+ *         be reached, or NaN and 0 if a negative edge
+ *         weight is reached
+ *
+ * Unit weight graphs are searched breadth first and others
+ * with Dijkstra's algorithm, counting paths as Brandes'
+ * algorithm does, which is why negative weights are
+ * refused. Costs within 1e-9 of each other count as equal.
+ */
+func (g *Undirected) CountShortestPaths(source, dest int) (float64, int) {
+	if !g.hasVertex(source) || !g.hasVertex(dest) {
+		return math.Inf(1), 0
+	}
+	_, _, sigma, dist, ok := g.shortestPathDAG(source)
+	if !ok {
+		return math.NaN(), 0
+	}
+	if math.IsInf(dist[dest], 1) {
+		return dist[dest], 0
+	}
+	return dist[dest], int(sigma[dest])
+}
+
+/**
+ * Finds the vertices whose cheapest path from start costs
+ * no more than a bound.
+ *
+ * @param start    the vertex to search from
+ * @param maxCost  the most a path from start may cost
+ * @return the vertices within maxCost of start, in order
+ *         of increasing cost, or nil if a negative edge
+ *         weight is reached
+ *
+ * This is Dijkstra's algorithm cut off at maxCost, so
+ * vertices beyond the bound are never queued. An empty
+ * slice is returned if start is not a vertex or maxCost is
+ * negative.
+ */
+func (g *Undirected) ReachableWithinWeight(start int, maxCost float64) []int {
+	order := []int{}
+	if !g.hasVertex(start) || maxCost < 0 {
+		return order
+	}
+
+	dist := make([]float64, g.numVertices)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	settled := make([]bool, g.numVertices)
+
+	dist[start] = 0
+	queue := &vertexQueue{{start, 0}}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(vertexItem)
+		if settled[item.vertex] {
+			continue
+		}
+		settled[item.vertex] = true
+		order = append(order, item.vertex)
+
+		for _, neighbor := range g.edges[item.vertex] {
+			weight := g.weights[item.vertex][neighbor]
+			if weight < 0 {
+				return nil
+			}
+			if alt := dist[item.vertex] + weight; alt <= maxCost && alt < dist[neighbor] {
+				dist[neighbor] = alt
+				heap.Push(queue, vertexItem{neighbor, alt})
+			}
+		}
+	}
+	return order
+}
+
+/**
+ * Single source shortest paths using Dijkstra's algorithm.
+ *
+ * @param source  the vertex to search from
+ * @param dest    stop once this vertex is settled, -1 to
+ *                settle every reachable vertex
+ * @return the distance to and predecessor of each vertex,
+ *         and false if a negative edge weight was found
+ *
+ * Unreached vertices have distance +Inf and predecessor -1.
+ * Both slices are nil if source is not a vertex.
+ */
+func (g *Undirected) dijkstra(source, dest int) ([]float64, []int, bool) {
+	if source < 0 || source >= g.numVertices {
+		return nil, nil, true
+	}
+
+	dist := make([]float64, g.numVertices)
+	prev := make([]int, g.numVertices)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prev[i] = -1
+	}
+	settled := make([]bool, g.numVertices)
+
+	dist[source] = 0
+	queue := &vertexQueue{{source, 0}}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(vertexItem)
+		if settled[item.vertex] {
+			continue
+		}
+		settled[item.vertex] = true
+		if item.vertex == dest {
+			break
+		}
+
+		for _, neighbor := range g.edges[item.vertex] {
+			weight := g.weights[item.vertex][neighbor]
+			if weight < 0 {
+				return nil, nil, false
+			}
+			if alt := dist[item.vertex] + weight; alt < dist[neighbor] {
+				dist[neighbor] = alt
+				prev[neighbor] = item.vertex
+				heap.Push(queue, vertexItem{neighbor, alt})
+			}
+		}
+	}
+	return dist, prev, true
+}
+
+/**
+ * NegativeCycleError reports a cycle of negative total
+ * weight, which leaves shortest paths undefined since it
+ * can be walked around forever.
+ */
+type NegativeCycleError struct {
+	Cycle []int // vertices in order around the cycle
+}
+
+func (e *NegativeCycleError) Error() string {
+	return fmt.Sprintf("graphs: negative weight cycle %v", e.Cycle)
+}
+
+// Is lets errors.Is match a NegativeCycleError against ErrNegativeCycle.
+func (e *NegativeCycleError) Is(target error) bool {
+	return target == ErrNegativeCycle
+}
+
+/**
+ * Single source shortest paths using the Bellman-Ford
+ * algorithm, which allows negative edge weights.
+ *
+ * @param source  the vertex to search from
+ * @return the cost of the cheapest path to each vertex,
+ *         +Inf for unreachable vertices, the vertex before
+ *         each one on its path, -1 for source and
+ *         unreached vertices, and ErrVertexOutOfRange or a
+ *         *NegativeCycleError holding a reachable cycle
+ *
+ * In an undirected graph an edge can be walked back and
+ * forth, so any reachable negative edge is a negative
+ * cycle of its two endpoints. Both slices are nil when
+ * there is an error. This takes O(nm) time.
+ */
+func (g *Undirected) BellmanFord(source int) ([]float64, []int, error) {
+	if !g.hasVertex(source) {
+		return nil, nil, fmt.Errorf("%w: source %d", ErrVertexOutOfRange, source)
+	}
+
+	dist := make([]float64, g.numVertices)
+	prev := make([]int, g.numVertices)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+		prev[i] = -1
+	}
+	dist[source] = 0
+
+	// relax returns the last vertex whose distance fell, or -1
+	relax := func() int {
+		last := -1
+		for u := 0; u < g.numVertices; u++ {
+			if math.IsInf(dist[u], 1) {
+				continue
+			}
+			for _, v := range g.edges[u] {
+				if alt := dist[u] + g.weights[u][v]; alt < dist[v] {
+					dist[v] = alt
+					prev[v] = u
+					last = v
+				}
+			}
+		}
+		return last
+	}
+
+	for i := 1; i < g.numVertices; i++ {
+		if relax() == -1 {
+			return dist, prev, nil
+		}
+	}
+	last := relax()
+	if last == -1 {
+		return dist, prev, nil
+	}
+
+	// after n steps back the walk must be on the cycle
+	for i := 0; i < g.numVertices; i++ {
+		last = prev[last]
+	}
+	cycle := []int{last}
+	for v := prev[last]; v != last; v = prev[v] {
+		cycle = append(cycle, v)
+	}
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return nil, nil, &NegativeCycleError{cycle}
+}
+
+/**
+ * Single source shortest path costs using the
+ * Bellman-Ford algorithm, as computed by BellmanFord.
+ *
+ * @param source  the vertex to search from
+ * @return the cost of the cheapest path to each vertex,
+ *         +Inf for unreachable vertices, and false if a
+ *         negative weight cycle is reachable from source
+ *
+ * The distances are nil when the result is false, and if
+ * source is not a vertex.
+ */
+func (g *Undirected) ShortestPathBellmanFord(source int) ([]float64, bool) {
+	dist, _, err := g.BellmanFord(source)
+	return dist, !errors.Is(err, ErrNegativeCycle)
+}
+
+/**
+ * AllPairs holds the cheapest paths between every pair of
+ * vertices, as computed by AllPairsShortestPaths, so they
+ * can be queried many times without searching again.
+ */
+type AllPairs struct {
+	dist [][]float64
+	next [][]int // the vertex after i on the path to j, -1 for none
+}
+
+/**
+ * Computes the cheapest paths between every pair of
+ * vertices using the Floyd-Warshall algorithm.
+ *
+ * @return the table of path costs and routes
+ *
+ * This takes O(n^3) time and O(n^2) memory, so it is only
+ * suitable for graphs of a few thousand vertices. Negative
+ * weights are allowed, but costs through a negative cycle
+ * are not meaningful.
+ */
+func (g *Undirected) AllPairsShortestPaths() *AllPairs {
+	n := g.numVertices
+	dist := make([][]float64, n)
+	next := make([][]int, n)
+	for i := 0; i < n; i++ {
+		dist[i] = make([]float64, n)
+		next[i] = make([]int, n)
+		for j := 0; j < n; j++ {
+			next[i][j] = -1
+			if i != j {
+				dist[i][j] = math.Inf(1)
+			}
+		}
+		next[i][i] = i
+		for _, j := range g.edges[i] {
+			dist[i][j] = g.weights[i][j]
+			next[i][j] = j
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if math.IsInf(dist[i][k], 1) {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if alt := dist[i][k] + dist[k][j]; alt < dist[i][j] {
+					dist[i][j] = alt
+					next[i][j] = next[i][k]
+				}
+			}
+		}
+	}
+	return &AllPairs{dist, next}
+}
+
+/**
+ * Accessor for the cost of the cheapest path from u to v.
+ *
+ * @param u  the vertex the path starts at
+ * @param v  the vertex the path ends at
+ * @return the cost, 0 when u is v, and +Inf if v cannot
+ *         be reached from u or either is not a vertex
+ */
+func (p *AllPairs) Dist(u, v int) float64 {
+	if u < 0 || u >= len(p.dist) || v < 0 || v >= len(p.dist) {
+		return math.Inf(1)
+	}
+	return p.dist[u][v]
+}
+
+/**
+ * Accessor for the cheapest path from u to v.
+ *
+ * @param u  the vertex the path starts at
+ * @param v  the vertex the path ends at
+ * @return the vertices along the path, u first, just u
+ *         when u is v, and nil if v cannot be reached from
+ *         u or either is not a vertex
+ *
+ * As with the costs, paths through a negative cycle are
+ * not meaningful; nil is returned if such a route loops.
+ */
+func (p *AllPairs) Path(u, v int) []int {
+	if math.IsInf(p.Dist(u, v), 1) {
+		return nil
+	}
+	path := []int{u}
+	for x := u; x != v; {
+		x = p.next[x][v]
+		path = append(path, x)
+		if len(path) > len(p.dist) {
+			return nil
+		}
+	}
+	return path
+}
+
+/**
+ * Accessor for every path cost at once.
+ *
+ * @return an n x n matrix where [i][j] is Dist(i, j),
+ *         copied so it may be modified
+ */
+func (p *AllPairs) Distances() [][]float64 {
+	dist := make([][]float64, len(p.dist))
+	for i, row := range p.dist {
+		dist[i] = append([]float64(nil), row...)
+	}
+	return dist
+}
+
+/**
+ * Follows a predecessor array back from a vertex.
+ *
+ * @param prev  predecessor of each vertex, -1 for none
+ * @param dest  the last vertex of the path
+ * @return the path ending at dest, in forward order
+ */
+func pathTo(prev []int, dest int) []int {
+	path := []int{}
+	for v := dest; v != -1; v = prev[v] {
+		path = append(path, v)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+/**
+ * A vertex and its tentative distance in a vertexQueue.
+ */
+type vertexItem struct {
+	vertex   int
+	priority float64
+}
+
+/**
+ * Min-priority queue of vertices implementing heap.Interface.
+ * Vertices may be pushed more than once; stale entries are
+ * skipped by the caller when popped.
+ */
+type vertexQueue []vertexItem
+
+func (q vertexQueue) Len() int { return len(q) }
+
+func (q vertexQueue) Less(i, j int) bool {
+	if q[i].priority == q[j].priority {
+		return q[i].vertex < q[j].vertex
+	}
+	return q[i].priority < q[j].priority
+}
+
+func (q vertexQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *vertexQueue) Push(x interface{}) { *q = append(*q, x.(vertexItem)) }
+
+func (q *vertexQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,54 @@
+package graphs
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestAStarZeroHeuristicMatchesShortestPath(t *testing.T) {
+	g, index := GridGraph(5, 6)
+	// uneven weights so the cheapest path is unique
+	for _, e := range g.EdgeList() {
+		g.SetWeight(e[0], e[1], 1+float64((e[0]*31+e[1]*17)%97)/100)
+	}
+	zero := func(v int) float64 { return 0 }
+
+	source, dest := index(0, 0), index(4, 5)
+	if _, count := g.CountShortestPaths(source, dest); count != 1 {
+		t.Fatalf("%d cheapest paths, want a unique one", count)
+	}
+	wantPath, wantCost := g.ShortestPath(source, dest)
+	for name, heuristic := range map[string]func(int) float64{"nil": nil, "zero": zero} {
+		path, cost := g.AStar(source, dest, heuristic)
+		if !reflect.DeepEqual(path, wantPath) || math.Abs(cost-wantCost) > weightEpsilon {
+			t.Errorf("AStar with %s heuristic = %v, %g, want %v, %g", name, path, cost, wantPath, wantCost)
+		}
+	}
+
+	// Manhattan distance never overestimates since every weight is at least 1
+	manhattan := func(v int) float64 {
+		r, c := v/6, v%6
+		return math.Abs(float64(4-r)) + math.Abs(float64(5-c))
+	}
+	if _, cost := g.AStar(source, dest, manhattan); math.Abs(cost-wantCost) > weightEpsilon {
+		t.Errorf("AStar with Manhattan heuristic cost %g, want %g", cost, wantCost)
+	}
+}
+
+func TestCountShortestPathsGrid(t *testing.T) {
+	g, index := GridGraph(3, 3)
+	// every path right twice and down twice, in any order
+	cost, count := g.CountShortestPaths(index(0, 0), index(2, 2))
+	if cost != 4 || count != 6 {
+		t.Errorf("CountShortestPaths(0, 8) = %g, %d, want 4, 6", cost, count)
+	}
+
+	g.RemoveEdge(index(1, 1), index(1, 2))
+	if cost, count := g.CountShortestPaths(index(0, 0), index(2, 2)); cost != 4 || count != 4 {
+		t.Errorf("CountShortestPaths(0, 8) = %g, %d without edge 4-5, want 4, 4", cost, count)
+	}
+	if cost, count := NewGraph(2).CountShortestPaths(0, 1); !math.IsInf(cost, 1) || count != 0 {
+		t.Errorf("CountShortestPaths(0, 1) = %g, %d with no edges, want +Inf, 0", cost, count)
+	}
+}
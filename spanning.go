@@ -0,0 +1,119 @@
+package graphs
+
+import (
+	"math"
+	"sort"
+)
+
+/**
+ * Computes a minimum spanning tree using Kruskal's algorithm.
+ *
+ * @return a new graph on the same vertices holding the tree
+ *         edges, and the total weight of the tree
+ *
+ * A disconnected graph yields a minimum spanning forest with
+ * the weight summed across its components. Edges of equal
+ * weight are considered in (smaller, larger) endpoint order
+ * so the result is reproducible. Edge directions are
+ * ignored, so the tree of a directed graph is undirected.
+ */
+func (g *Undirected) MinimumSpanningTree() (*Undirected, float64) {
+	edges := g.WeightedEdgeList()
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].W < edges[j].W
+	})
+
+	tree := NewGraph(g.numVertices)
+	total := 0.0
+	sets := NewDisjointSet(g.numVertices)
+	for _, e := range edges {
+		if sets.Union(e.U, e.V) {
+			tree.AddEdgeWeight(e.U, e.V, e.W)
+			total += e.W
+		}
+	}
+	return tree, total
+}
+
+/**
+ * Computes a minimum spanning tree using Prim's algorithm,
+ * growing it from a chosen vertex.
+ *
+ * @param start  the vertex the tree is grown from
+ * @return a new graph on the same vertices holding the tree
+ *         edges, and the total weight of the tree
+ *
+ * The adjacency matrix is scanned directly instead of
+ * keeping a heap, taking O(n^2) time, which beats
+ * MinimumSpanningTree on dense graphs. A disconnected
+ * graph yields a minimum spanning forest whose other
+ * trees are grown from their smallest vertex. If start is
+ * not a vertex the tree has no edges. Edge directions are
+ * ignored, as they are by MinimumSpanningTree.
+ */
+func (g *Undirected) MinimumSpanningTreePrim(start int) (*Undirected, float64) {
+	if g.directed {
+		return g.underlying().MinimumSpanningTreePrim(start)
+	}
+	tree := NewGraph(g.numVertices)
+	if !g.hasVertex(start) {
+		return tree, 0
+	}
+
+	inTree := make([]bool, g.numVertices)
+	cost := make([]float64, g.numVertices)
+	parent := make([]int, g.numVertices)
+	for v := range cost {
+		cost[v] = math.Inf(1)
+		parent[v] = -1
+	}
+
+	total := 0.0
+	for next := start; next != -1; {
+		v := next
+		inTree[v] = true
+		if parent[v] != -1 {
+			tree.AddEdgeWeight(parent[v], v, cost[v])
+			total += cost[v]
+		}
+
+		next = -1
+		for u := 0; u < g.numVertices; u++ {
+			if inTree[u] {
+				continue
+			}
+			if g.IsConnected(v, u) && g.weights[v][u] < cost[u] {
+				cost[u] = g.weights[v][u]
+				parent[u] = v
+			}
+			if next == -1 || cost[u] < cost[next] {
+				next = u
+			}
+		}
+	}
+	return tree, total
+}
+
+/**
+ * Builds a breadth first spanning tree of root's component.
+ *
+ * @param root  the vertex the tree is grown from
+ * @return a new graph on the same vertices holding the tree
+ *         edges with their weights, and the parent of each
+ *         vertex: -1 for root and -2 for vertices that
+ *         cannot be reached from root
+ *
+ * The tree is a shortest hop tree, and neighbors are
+ * explored in ascending order. If root is not a vertex
+ * the tree has no edges and every parent is -2.
+ */
+func (g *Undirected) BFSSpanningTree(root int) (*Undirected, []int) {
+	tree := g.emptyLike(g.numVertices)
+	parent, _ := g.BFSVisit(root, func(v, parent, depth int) bool {
+		if parent >= 0 {
+			tree.AddEdgeWeight(parent, v, g.weights[parent][v])
+		}
+		return true
+	})
+	return tree, parent
+}
@@ -0,0 +1,321 @@
+package graphs
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+/**
+ * GraphStats summarizes the size and degrees of a graph.
+ * In a directed graph the degrees are out-degrees.
+ */
+type GraphStats struct {
+	NumVertices         int
+	NumEdges            int
+	MinDegree           int
+	MaxDegree           int
+	AvgDegree           float64
+	Density             float64
+	NumIsolatedVertices int
+}
+
+/**
+ * Computes summary statistics in a single pass over the degrees.
+ *
+ * @return the statistics of the graph
+ *
+ * Density is the fraction of possible edges present,
+ * 2m / (n(n-1)) for an undirected graph, and is 0 when
+ * there are fewer than two vertices.
+ */
+func (g *Undirected) Stats() GraphStats {
+	stats := GraphStats{NumVertices: g.numVertices, NumEdges: g.numEdges}
+	if g.numVertices == 0 {
+		return stats
+	}
+
+	stats.MinDegree = g.degrees[0]
+	total := 0
+	for v, degree := range g.degrees {
+		if degree < stats.MinDegree {
+			stats.MinDegree = degree
+		}
+		if degree > stats.MaxDegree {
+			stats.MaxDegree = degree
+		}
+		if degree == 0 && g.InDegree(v) == 0 {
+			stats.NumIsolatedVertices++
+		}
+		total += degree
+	}
+	stats.AvgDegree = float64(total) / float64(g.numVertices)
+
+	if g.numVertices > 1 {
+		pairs := float64(g.numVertices) * float64(g.numVertices-1)
+		if g.directed {
+			stats.Density = float64(g.numEdges) / pairs
+		} else {
+			stats.Density = 2 * float64(g.numEdges) / pairs
+		}
+	}
+	return stats
+}
+
+/**
+ * Formats the statistics for logging.
+ *
+ * @return the statistics on a single line
+ */
+func (s GraphStats) String() string {
+	return fmt.Sprintf("vertices=%d edges=%d degree[min=%d max=%d avg=%.3f] density=%.4f isolated=%d",
+		s.NumVertices, s.NumEdges, s.MinDegree, s.MaxDegree, s.AvgDegree, s.Density,
+		s.NumIsolatedVertices)
+}
+
+/**
+ * Accessor for the degrees of all vertices.
+ *
+ * @return a copy of the degrees sorted in non-increasing order
+ */
+func (g *Undirected) DegreeSequence() []int {
+	sequence := append([]int(nil), g.degrees...)
+	sort.Sort(sort.Reverse(sort.IntSlice(sequence)))
+	return sequence
+}
+
+/**
+ * Accessor for the largest degree in the graph.
+ *
+ * @return the maximum degree, 0 for an empty graph
+ */
+func (g *Undirected) MaxDegree() int {
+	_, degree := g.MaxDegreeVertex()
+	return degree
+}
+
+/**
+ * Accessor for the smallest degree in the graph.
+ *
+ * @return the minimum degree, 0 for an empty graph
+ */
+func (g *Undirected) MinDegree() int {
+	_, degree := g.MinDegreeVertex()
+	return degree
+}
+
+/**
+ * Finds a vertex of largest degree.
+ *
+ * @return the smallest such vertex and its degree, or
+ *         -1 and 0 for an empty graph
+ */
+func (g *Undirected) MaxDegreeVertex() (int, int) {
+	if g.numVertices == 0 {
+		return -1, 0
+	}
+	vertex := 0
+	for v, degree := range g.degrees {
+		if degree > g.degrees[vertex] {
+			vertex = v
+		}
+	}
+	return vertex, g.degrees[vertex]
+}
+
+/**
+ * Finds a vertex of smallest degree.
+ *
+ * @return the smallest such vertex and its degree, or
+ *         -1 and 0 for an empty graph
+ */
+func (g *Undirected) MinDegreeVertex() (int, int) {
+	if g.numVertices == 0 {
+		return -1, 0
+	}
+	vertex := 0
+	for v, degree := range g.degrees {
+		if degree < g.degrees[vertex] {
+			vertex = v
+		}
+	}
+	return vertex, g.degrees[vertex]
+}
+
+/**
+ * Counts how many vertices have each degree.
+ *
+ * @return the number of vertices keyed by degree, with
+ *         only degrees that occur present
+ */
+func (g *Undirected) DegreeDistribution() map[int]int {
+	distribution := make(map[int]int)
+	for _, degree := range g.degrees {
+		distribution[degree]++
+	}
+	return distribution
+}
+
+/**
+ * Counts how many vertices have each degree, as a dense
+ * histogram.
+ *
+ * @return a slice of length MaxDegree()+1 where index d
+ *         holds the number of vertices of degree d, empty
+ *         for an empty graph
+ */
+func (g *Undirected) DegreeDistributionSlice() []int {
+	if g.numVertices == 0 {
+		return []int{}
+	}
+	distribution := make([]int, g.MaxDegree()+1)
+	for _, degree := range g.degrees {
+		distribution[degree]++
+	}
+	return distribution
+}
+
+/**
+ * Computes the local clustering coefficient of a vertex.
+ *
+ * @param v  vertex in the graph
+ * @return the fraction of pairs of v's neighbors that are
+ *         themselves adjacent, 0 if v has fewer than two
+ *         neighbors or is not in the graph
+ *
+ * Edge directions are ignored, so in a directed graph the
+ * neighbors are the vertices joined to v either way.
+ */
+func (g *Undirected) ClusteringCoefficient(v int) float64 {
+	if g.directed {
+		return g.underlying().ClusteringCoefficient(v)
+	}
+	degree := g.Degree(v)
+	if degree < 2 {
+		return 0
+	}
+	pairs := degree * (degree - 1) / 2
+	return float64(g.neighborLinks(v)) / float64(pairs)
+}
+
+/**
+ * Computes the degree assortativity coefficient, the
+ * Pearson correlation between the degrees at either end
+ * of an edge, following Newman.
+ *
+ * @return a value in [-1, 1], positive when high degree
+ *         vertices tend to be adjacent to each other, and 0
+ *         if the graph has no edges or the degrees at the
+ *         ends of the edges do not vary, as in a regular
+ *         graph
+ *
+ * An undirected edge is counted in both directions. In a
+ * directed graph the out-degree of each tail is correlated
+ * with the in-degree of each head.
+ */
+func (g *Undirected) DegreeAssortativity() float64 {
+	var n, sumX, sumY, sumXY, sumXX, sumYY float64
+	add := func(x, y float64) {
+		n++
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+		sumYY += y * y
+	}
+
+	for _, e := range g.EdgeList() {
+		if g.directed {
+			add(float64(g.degrees[e[0]]), float64(g.inDegrees[e[1]]))
+			continue
+		}
+		x, y := float64(g.degrees[e[0]]), float64(g.degrees[e[1]])
+		add(x, y)
+		add(y, x)
+	}
+	if n == 0 {
+		return 0
+	}
+
+	covariance := sumXY/n - (sumX/n)*(sumY/n)
+	varianceX := sumXX/n - (sumX/n)*(sumX/n)
+	varianceY := sumYY/n - (sumY/n)*(sumY/n)
+	if varianceX <= weightEpsilon || varianceY <= weightEpsilon {
+		return 0
+	}
+	return covariance / math.Sqrt(varianceX*varianceY)
+}
+
+/**
+ * Computes the global clustering coefficient (transitivity).
+ *
+ * @return 3 * triangles / connected triples, 0 if the
+ *         graph has no connected triples
+ *
+ * A directed graph is measured on its underlying
+ * undirected graph, as by CountTriangles.
+ */
+func (g *Undirected) GlobalClusteringCoefficient() float64 {
+	if g.directed {
+		return g.underlying().GlobalClusteringCoefficient()
+	}
+	triples := 0
+	for _, degree := range g.degrees {
+		triples += degree * (degree - 1) / 2
+	}
+	if triples == 0 {
+		return 0
+	}
+	return 3 * float64(g.CountTriangles()) / float64(triples)
+}
+
+/**
+ * Counts the triangles in the graph.
+ *
+ * @return the number of distinct sets of three mutually
+ *         adjacent vertices
+ *
+ * Each edge xy with x > y is paired with the common
+ * neighbors w < y, so every triangle is counted once.
+ * Edge directions are ignored, so in a directed graph any
+ * three vertices joined pairwise by edges either way form a
+ * triangle, whether or not the edges make a cycle.
+ */
+func (g *Undirected) CountTriangles() int {
+	if g.directed {
+		return g.underlying().CountTriangles()
+	}
+	triangles := 0
+	for x := 0; x < g.numVertices; x++ {
+		for y := 0; y < x; y++ {
+			if !g.adjacencies[x][y] {
+				continue
+			}
+			for _, w := range g.edges[y] {
+				if w < y && g.adjacencies[x][w] {
+					triangles++
+				}
+			}
+		}
+	}
+	return triangles
+}
+
+/**
+ * Counts the edges among the neighbors of a vertex.
+ *
+ * @param v  vertex in the graph
+ * @return number of adjacent pairs of v's neighbors
+ */
+func (g *Undirected) neighborLinks(v int) int {
+	links := 0
+	neighbors := g.edges[v]
+	for i := range neighbors {
+		for j := i + 1; j < len(neighbors); j++ {
+			if g.IsConnected(neighbors[i], neighbors[j]) {
+				links++
+			}
+		}
+	}
+	return links
+}
@@ -0,0 +1,15 @@
+package graphs
+
+import "testing"
+
+func TestCountTrianglesComplete(t *testing.T) {
+	for n := 0; n <= 8; n++ {
+		want := n * (n - 1) * (n - 2) / 6
+		if got := CompleteGraph(n).CountTriangles(); got != want {
+			t.Errorf("CompleteGraph(%d).CountTriangles() = %d, want %d", n, got, want)
+		}
+	}
+	if got := CycleGraph(5).CountTriangles(); got != 0 {
+		t.Errorf("CycleGraph(5).CountTriangles() = %d, want 0", got)
+	}
+}
@@ -0,0 +1,150 @@
+package graphs
+
+import (
+	"sync"
+)
+
+/**
+ * SyncUndirected guards a graph with a read-write lock so
+ * queries may run concurrently while mutations are
+ * serialized. Undirected itself does no locking.
+ *
+ * Once wrapped, the graph should only be reached through
+ * the wrapper; Read and Write give locked access to any
+ * method not mirrored here.
+ *
+ * The mirrored methods behave exactly as the Undirected
+ * methods of the same name. AddVertex, AddEdge,
+ * AddEdgeWeight, RemoveEdge and SetWeight hold the write
+ * lock, and the queries hold the read lock. GetEdges is
+ * left out because its slice is the graph's own storage,
+ * which could change once the lock is released; use
+ * Neighbors for a copy.
+ */
+type SyncUndirected struct {
+	mu sync.RWMutex
+	g  *Undirected
+}
+
+/**
+ * Constructor wraps a graph for concurrent use.
+ *
+ * @param g  the graph to guard
+ */
+func NewSyncUndirected(g *Undirected) *SyncUndirected {
+	return &SyncUndirected{g: g}
+}
+
+/**
+ * Runs fn with the graph locked for reading. fn must not
+ * modify the graph or keep it after returning.
+ *
+ * @param fn  the query to run
+ */
+func (s *SyncUndirected) Read(fn func(g *Undirected)) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fn(s.g)
+}
+
+/**
+ * Runs fn with the graph locked for writing. fn must not
+ * keep the graph after returning.
+ *
+ * @param fn  the update to run
+ */
+func (s *SyncUndirected) Write(fn func(g *Undirected)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn(s.g)
+}
+
+func (s *SyncUndirected) AddVertex() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.g.AddVertex()
+}
+
+func (s *SyncUndirected) AddEdge(vertex1, vertex2 int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.AddEdge(vertex1, vertex2)
+}
+
+func (s *SyncUndirected) AddEdgeWeight(vertex1, vertex2 int, weight float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.AddEdgeWeight(vertex1, vertex2, weight)
+}
+
+func (s *SyncUndirected) RemoveEdge(vertex1, vertex2 int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.g.RemoveEdge(vertex1, vertex2)
+}
+
+func (s *SyncUndirected) SetWeight(vertex1, vertex2 int, weight float64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.g.SetWeight(vertex1, vertex2, weight)
+}
+
+func (s *SyncUndirected) Order() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.Order()
+}
+
+func (s *SyncUndirected) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.Size()
+}
+
+func (s *SyncUndirected) Degree(i int) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.Degree(i)
+}
+
+func (s *SyncUndirected) IsConnected(vertex1, vertex2 int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.IsConnected(vertex1, vertex2)
+}
+
+func (s *SyncUndirected) HasEdge(vertex1, vertex2 int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.HasEdge(vertex1, vertex2)
+}
+
+func (s *SyncUndirected) IsDirected() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.IsDirected()
+}
+
+func (s *SyncUndirected) Weight(vertex1, vertex2 int) float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.Weight(vertex1, vertex2)
+}
+
+func (s *SyncUndirected) Neighbors(vertex int) []int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.Neighbors(vertex)
+}
+
+func (s *SyncUndirected) BFS(start int) []int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.BFS(start)
+}
+
+func (s *SyncUndirected) ShortestPath(source, dest int) ([]int, float64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.g.ShortestPath(source, dest)
+}
@@ -0,0 +1,48 @@
+package graphs
+
+import (
+	"sync"
+	"testing"
+)
+
+// Run with -race: the writers grow adjacency lists while the
+// readers walk and copy them, and edges are only ever added
+// so a neighbor once seen stays connected.
+func TestSyncUndirectedConcurrentAccess(t *testing.T) {
+	const n = 64
+	s := NewSyncUndirected(NewGraph(n))
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(2)
+		go func(w int) {
+			defer wg.Done()
+			for v := w; v < n; v += 4 {
+				for u := 0; u < v; u += 3 {
+					s.AddEdge(u, v)
+				}
+			}
+		}(w)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				v := (w + i) % n
+				s.BFS(v)
+				for _, u := range s.Neighbors(v) {
+					if !s.IsConnected(u, v) {
+						t.Errorf("neighbor %d of %d has no edge", u, v)
+					}
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	want := 0
+	for v := 0; v < n; v++ {
+		want += (v + 2) / 3
+	}
+	if got := s.Size(); got != want {
+		t.Errorf("Size() = %d after concurrent AddEdge calls, want %d", got, want)
+	}
+}
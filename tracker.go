@@ -0,0 +1,165 @@
+package graphs
+
+/**
+ * ComponentTracker keeps the connected components of an
+ * undirected graph up to date while edges are removed or
+ * added, without recomputing them from scratch.
+ *
+ * Once wrapped, edges should only be added and removed
+ * through the tracker. Changing edges or vertices behind
+ * its back leaves the component labels stale.
+ */
+type ComponentTracker struct {
+	g             *Undirected
+	label         []int
+	nextLabel     int
+	numComponents int
+}
+
+/**
+ * Constructor labels the components of a graph.
+ *
+ * @param g  the graph to track
+ * @return the tracker, or nil if g is directed
+ *
+ * Construction takes O(n + m) time.
+ */
+func NewComponentTracker(g *Undirected) *ComponentTracker {
+	if g.directed {
+		return nil
+	}
+	t := &ComponentTracker{g: g, label: make([]int, g.numVertices)}
+	for _, component := range g.ConnectedComponents() {
+		for _, v := range component {
+			t.label[v] = t.nextLabel
+		}
+		t.nextLabel++
+	}
+	t.numComponents = t.nextLabel
+	return t
+}
+
+/**
+ * Accessor for the tracked graph.
+ *
+ * @return the graph, which must not be modified directly
+ */
+func (t *ComponentTracker) Graph() *Undirected {
+	return t.g
+}
+
+/**
+ * Accessor for the number of connected components.
+ *
+ * @return number of connected components in the graph
+ */
+func (t *ComponentTracker) NumComponents() int {
+	return t.numComponents
+}
+
+/**
+ * Accessor for whether two vertices share a component.
+ *
+ * @param u  vertex in the graph
+ * @param v  vertex in the graph
+ * @return true if a path joins u and v, false if either
+ *         is not a vertex
+ */
+func (t *ComponentTracker) SameComponent(u, v int) bool {
+	if !t.g.hasVertex(u) || !t.g.hasVertex(v) {
+		return false
+	}
+	return t.label[u] == t.label[v]
+}
+
+/**
+ * Removes the edge uv and updates the components.
+ *
+ * @param u  one endpoint
+ * @param v  one endpoint
+ * @return true if removing the edge split a component
+ *
+ * Nothing happens if uv is not in the graph. After the
+ * edge is gone, breadth first searches from u and v are
+ * run in lockstep until they meet or one runs out. So if
+ * uv was a bridge the cost is proportional to the edges of
+ * the smaller side, which is then relabeled, and otherwise
+ * it is bounded by how far apart the two searches are,
+ * never more than the size of the component.
+ */
+func (t *ComponentTracker) RemoveEdge(u, v int) bool {
+	if !t.g.IsConnected(u, v) {
+		return false
+	}
+	t.g.RemoveEdge(u, v)
+
+	// side[x] is 1 if reached from u, 2 if reached from v
+	side := map[int]int{u: 1, v: 2}
+	queues := [2][]int{{u}, {v}}
+	reached := [2][]int{{u}, {v}}
+
+	for {
+		for s := 0; s < 2; s++ {
+			if len(queues[s]) == 0 {
+				t.relabel(reached[s])
+				return true
+			}
+			x := queues[s][0]
+			queues[s] = queues[s][1:]
+			for _, y := range t.g.edges[x] {
+				switch side[y] {
+				case 0:
+					side[y] = s + 1
+					queues[s] = append(queues[s], y)
+					reached[s] = append(reached[s], y)
+				case 2 - s:
+					return false
+				}
+			}
+		}
+	}
+}
+
+/**
+ * Adds a unit weight edge uv, as by AddEdge, and merges the
+ * components of u and v.
+ *
+ * @param u  one endpoint
+ * @param v  one endpoint
+ * @return true if the edge joined two components
+ *
+ * Nothing happens if either is not a vertex or uv is a
+ * self loop. A merge relabels v's component by scanning
+ * every label, taking O(n) time.
+ */
+func (t *ComponentTracker) AddEdge(u, v int) bool {
+	if !t.g.hasVertex(u) || !t.g.hasVertex(v) || u == v {
+		return false
+	}
+	t.g.AddEdge(u, v)
+
+	from, to := t.label[v], t.label[u]
+	if from == to {
+		return false
+	}
+	for x, l := range t.label {
+		if l == from {
+			t.label[x] = to
+		}
+	}
+	t.numComponents--
+	return true
+}
+
+/**
+ * Moves vertices split off a component to a new label.
+ *
+ * @param vertices  every vertex of the new component
+ */
+func (t *ComponentTracker) relabel(vertices []int) {
+	for _, v := range vertices {
+		t.label[v] = t.nextLabel
+	}
+	t.nextLabel++
+	t.numComponents++
+}
@@ -0,0 +1,44 @@
+package graphs
+
+import "testing"
+
+func TestComponentTracker(t *testing.T) {
+	g := NewGraphFromEdges(6, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}})
+	tracker := NewComponentTracker(g)
+	if got := tracker.NumComponents(); got != 3 {
+		t.Fatalf("NumComponents() = %d, want 3", got)
+	}
+
+	// removing a triangle edge leaves it connected, the
+	// next removal splits 0 off
+	if tracker.RemoveEdge(0, 1) {
+		t.Error("RemoveEdge(0, 1) split a triangle")
+	}
+	if !tracker.RemoveEdge(2, 0) || tracker.NumComponents() != 4 || tracker.SameComponent(0, 1) {
+		t.Errorf("RemoveEdge(2, 0) did not split off 0: %d components", tracker.NumComponents())
+	}
+
+	if !tracker.AddEdge(0, 5) || tracker.NumComponents() != 3 || !tracker.SameComponent(5, 0) {
+		t.Errorf("AddEdge(0, 5) did not merge: %d components", tracker.NumComponents())
+	}
+	if !tracker.AddEdge(4, 1) || tracker.NumComponents() != 2 || !tracker.SameComponent(3, 2) {
+		t.Errorf("AddEdge(4, 1) did not merge: %d components", tracker.NumComponents())
+	}
+	if tracker.AddEdge(3, 2) || tracker.NumComponents() != 2 {
+		t.Errorf("AddEdge(3, 2) merged a component with itself: %d components", tracker.NumComponents())
+	}
+	if tracker.AddEdge(0, 9) {
+		t.Error("AddEdge(0, 9) merged with a non-vertex")
+	}
+
+	// the tracker agrees with a recount after every change
+	if got, want := tracker.NumComponents(), g.NumComponents(); got != want {
+		t.Errorf("NumComponents() = %d, recount gives %d", got, want)
+	}
+	if !tracker.RemoveEdge(0, 5) || tracker.NumComponents() != 3 {
+		t.Errorf("RemoveEdge(0, 5) did not split off 5: %d components", tracker.NumComponents())
+	}
+	if NewComponentTracker(NewDirectedGraph(2).Undirected) != nil {
+		t.Error("NewComponentTracker accepted a directed graph")
+	}
+}
@@ -0,0 +1,250 @@
+package graphs
+
+import (
+	"math/rand"
+	"sort"
+)
+
+/**
+ * Breadth first traversal of the graph.
+ *
+ * @param start  the vertex to start from
+ * @return the vertices reachable from start, in the
+ *         order they are first discovered
+ *
+ * Neighbors are visited in ascending order. An empty
+ * slice is returned if start is not a vertex.
+ */
+func (g *Undirected) BFS(start int) []int {
+	order := []int{}
+	g.BFSVisit(start, func(v, parent, depth int) bool {
+		order = append(order, v)
+		return true
+	})
+	return order
+}
+
+/**
+ * Breadth first traversal of the graph that reports each
+ * vertex to a visitor.
+ *
+ * @param start  the vertex to start from
+ * @param visit  called the first time each vertex is
+ *               discovered with its parent in the search
+ *               tree and its depth in edges from start;
+ *               returning false stops the search. May be nil
+ * @return the parent of each vertex, -1 for start and -2
+ *         for vertices not reached, and the depth of each
+ *         vertex, -1 for vertices not reached
+ *
+ * Neighbors are visited in ascending order, so vertices are
+ * visited in the order BFS returns them. If the search is
+ * stopped only the vertices visited so far are marked as
+ * reached. Nothing is reached if start is not a vertex.
+ */
+func (g *Undirected) BFSVisit(start int, visit func(v, parent, depth int) bool) ([]int, []int) {
+	parent := make([]int, g.numVertices)
+	depth := make([]int, g.numVertices)
+	for v := range parent {
+		parent[v] = -2
+		depth[v] = -1
+	}
+	if !g.hasVertex(start) {
+		return parent, depth
+	}
+
+	parent[start] = -1
+	depth[start] = 0
+	if visit != nil && !visit(start, -1, 0) {
+		return parent, depth
+	}
+	queue := []int{start}
+
+	for len(queue) > 0 {
+		vertex := queue[0]
+		queue = queue[1:]
+
+		for _, neighbor := range g.sortedEdges(vertex) {
+			if depth[neighbor] != -1 {
+				continue
+			}
+			parent[neighbor] = vertex
+			depth[neighbor] = depth[vertex] + 1
+			if visit != nil && !visit(neighbor, vertex, depth[neighbor]) {
+				return parent, depth
+			}
+			queue = append(queue, neighbor)
+		}
+	}
+	return parent, depth
+}
+
+/**
+ * Breadth first traversal limited to a number of hops.
+ *
+ * @param start    the vertex to start from
+ * @param maxHops  the most edges a vertex may be from start
+ * @return the vertices within maxHops edges of start, in
+ *         the order they are first discovered
+ *
+ * Vertices at the limit are not expanded, so the search
+ * never looks beyond maxHops edges. A maxHops of 0 gives
+ * just start. An empty slice is returned if start is not
+ * a vertex or maxHops is negative.
+ */
+func (g *Undirected) BFSWithinDistance(start, maxHops int) []int {
+	order := []int{}
+	if !g.hasVertex(start) || maxHops < 0 {
+		return order
+	}
+
+	visited := make([]bool, g.numVertices)
+	visited[start] = true
+	frontier := []int{start}
+
+	for hops := 0; len(frontier) > 0; hops++ {
+		order = append(order, frontier...)
+		if hops == maxHops {
+			break
+		}
+
+		next := []int{}
+		for _, vertex := range frontier {
+			for _, neighbor := range g.sortedEdges(vertex) {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+		}
+		frontier = next
+	}
+	return order
+}
+
+/**
+ * Depth first traversal of the graph.
+ *
+ * @param start  the vertex to start from
+ * @param visit  called the first time each vertex is
+ *               discovered
+ *
+ * Neighbors are explored in ascending order. The traversal
+ * uses an explicit stack so large graphs do not exhaust the
+ * goroutine stack. Nothing is visited if start is not a vertex.
+ */
+func (g *Undirected) DFS(start int, visit func(v int)) {
+	g.depthFirst(start, visit, nil)
+}
+
+/**
+ * Depth first traversal of the graph in finishing order.
+ *
+ * @param start  the vertex to start from
+ * @return the vertices reachable from start, in the
+ *         order their depth first search finishes
+ */
+func (g *Undirected) DFSPostorder(start int) []int {
+	order := []int{}
+	g.depthFirst(start, nil, func(v int) {
+		order = append(order, v)
+	})
+	return order
+}
+
+/**
+ * Depth first search from a single vertex shared by DFS
+ * and DFSPostorder.
+ *
+ * @param start  the vertex to start from
+ * @param pre    called when a vertex is discovered, may be nil
+ * @param post   called when a vertex is finished, may be nil
+ */
+func (g *Undirected) depthFirst(start int, pre, post func(v int)) {
+	if !g.hasVertex(start) {
+		return
+	}
+	time := 0
+	g.depthFirstFrom(start, g.newDFSResult(), &time, DFSHooks{PreVisit: pre, PostVisit: post})
+}
+
+/**
+ * Walks the graph choosing each next vertex uniformly
+ * among the neighbors of the current one.
+ *
+ * @param start  the vertex to start from
+ * @param steps  the maximum number of edges to follow
+ * @param rng    source of randomness
+ * @return the vertices visited, beginning with start
+ *
+ * The walk stops early at a vertex with no neighbors. An
+ * empty slice is returned if start is not a vertex.
+ */
+func (g *Undirected) RandomWalk(start, steps int, rng *rand.Rand) []int {
+	if !g.hasVertex(start) {
+		return []int{}
+	}
+	walk := []int{start}
+	for v := start; len(walk) <= steps && len(g.edges[v]) > 0; {
+		v = g.edges[v][rng.Intn(len(g.edges[v]))]
+		walk = append(walk, v)
+	}
+	return walk
+}
+
+/**
+ * Walks the graph choosing each next vertex with
+ * probability proportional to the weight of the edge to it.
+ *
+ * @param start  the vertex to start from
+ * @param steps  the maximum number of edges to follow
+ * @param rng    source of randomness
+ * @return the vertices visited, beginning with start
+ *
+ * Edges with non-positive weight are never taken, and the
+ * walk stops early at a vertex with no such edges left.
+ */
+func (g *Undirected) WeightedRandomWalk(start, steps int, rng *rand.Rand) []int {
+	if !g.hasVertex(start) {
+		return []int{}
+	}
+	walk := []int{start}
+	for v := start; len(walk) <= steps; {
+		total := 0.0
+		for _, neighbor := range g.edges[v] {
+			if w := g.weights[v][neighbor]; w > 0 {
+				total += w
+			}
+		}
+		if total == 0 {
+			break
+		}
+
+		next := -1
+		target := rng.Float64() * total
+		for _, neighbor := range g.edges[v] {
+			if w := g.weights[v][neighbor]; w > 0 {
+				next = neighbor
+				if target -= w; target < 0 {
+					break
+				}
+			}
+		}
+		v = next
+		walk = append(walk, v)
+	}
+	return walk
+}
+
+/**
+ * Accessor for edges of a vertex in ascending order.
+ *
+ * @param vertex  the vertex whos edges are to be retrieved
+ * @return a sorted copy of the adjacency list of vertex
+ */
+func (g *Undirected) sortedEdges(vertex int) []int {
+	neighbors := make([]int, len(g.edges[vertex]))
+	copy(neighbors, g.edges[vertex])
+	sort.Ints(neighbors)
+	return neighbors
+}
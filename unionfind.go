@@ -0,0 +1,84 @@
+package graphs
+
+/**
+ * DisjointSet is a union-find structure over the
+ * elements 0..n-1 using union by rank and path halving,
+ * so each operation takes nearly constant amortized time.
+ * It backs MinimumSpanningTree and MinCut, and is
+ * exported for callers tracking connectivity themselves.
+ */
+type DisjointSet struct {
+	parent []int
+	rank   []int
+	count  int
+}
+
+/**
+ * Constructor sets up n singleton sets.
+ *
+ * @param n  number of elements
+ */
+func NewDisjointSet(n int) *DisjointSet {
+	s := &DisjointSet{make([]int, n), make([]int, n), n}
+	for i := range s.parent {
+		s.parent[i] = i
+	}
+	return s
+}
+
+/**
+ * Finds the representative of the set containing x.
+ *
+ * @param x  an element
+ * @return the representative element of x's set
+ */
+func (s *DisjointSet) Find(x int) int {
+	for s.parent[x] != x {
+		s.parent[x] = s.parent[s.parent[x]]
+		x = s.parent[x]
+	}
+	return x
+}
+
+/**
+ * Merges the sets containing x and y.
+ *
+ * @param x  an element
+ * @param y  an element
+ * @return false if x and y were already in the same set
+ */
+func (s *DisjointSet) Union(x, y int) bool {
+	x, y = s.Find(x), s.Find(y)
+	if x == y {
+		return false
+	}
+	if s.rank[x] < s.rank[y] {
+		x, y = y, x
+	}
+	s.parent[y] = x
+	if s.rank[x] == s.rank[y] {
+		s.rank[x]++
+	}
+	s.count--
+	return true
+}
+
+/**
+ * Accessor for whether two elements share a set.
+ *
+ * @param x  an element
+ * @param y  an element
+ * @return true if x and y are in the same set
+ */
+func (s *DisjointSet) Connected(x, y int) bool {
+	return s.Find(x) == s.Find(y)
+}
+
+/**
+ * Accessor for the number of disjoint sets.
+ *
+ * @return the number of sets, n less the successful unions
+ */
+func (s *DisjointSet) Count() int {
+	return s.count
+}